@@ -0,0 +1,247 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+const defaultMaxPerRequest int = 50
+
+// a set of ById and List queries, possibly of different models, sent together as aliased root fields of as few
+// requests as possible. queries are added with GetById and List, which return the index of the query's result in
+// the BatchResults returned by Client.ExecuteBatch.
+type Batch struct {
+	items []batchItem
+}
+
+type batchItem struct {
+	queryType QueryType
+	model     modelFields
+	id        string
+	opts      *RequestOptions
+	err       error // error adding the query, returned by ExecuteBatch
+}
+
+// options for Client.ExecuteBatch
+type BatchOptions struct {
+	MaxPerRequest int // maximum number of queries (aliased root fields) sent in one request. larger batches are split. `50` is the default.
+}
+
+// results of Client.ExecuteBatch, in the order the queries were added to the Batch
+type BatchResults struct {
+	values []any
+}
+
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// GetById adds a ById query for the model (e.g. "pool") and returns the index of its result
+func (b *Batch) GetById(model string, id string, opts *RequestOptions) int {
+	return b.add(ById, model, id, opts)
+}
+
+// List adds a List query for the model (e.g. "swap") and returns the index of its result
+func (b *Batch) List(model string, opts *RequestOptions) int {
+	return b.add(List, model, "", opts)
+}
+
+// Len returns the number of queries in the batch
+func (b *Batch) Len() int {
+	return len(b.items)
+}
+
+func (b *Batch) add(queryType QueryType, model string, id string, opts *RequestOptions) int {
+	item := batchItem{queryType: queryType, id: id}
+	fields, ok := modelMap[model]
+	if !ok {
+		item.err = fmt.Errorf("batch error: unrecognized model (%s)", model)
+	}
+	item.model = fields
+	if opts != nil {
+		// the query constructors fill in defaults on opts, so each query gets its own copy
+		copied := *opts
+		item.opts = &copied
+	}
+	if hasStalenessGuard(opts) || (opts != nil && opts.AllowPartialData) {
+		item.err = fmt.Errorf("batch error: MaxBlockLag, MaxAge and AllowPartialData are not supported in batches (query %d)", len(b.items))
+	}
+	b.items = append(b.items, item)
+	return len(b.items) - 1
+}
+
+// Len returns the number of results
+func (r *BatchResults) Len() int {
+	return len(r.values)
+}
+
+// Found reports whether the query at index i returned a result. ById queries for missing ids return no result.
+func (r *BatchResults) Found(i int) bool {
+	return i >= 0 && i < len(r.values) && r.values[i] != nil
+}
+
+// Decode decodes the result of the query at index i into out, e.g. a *Pool for a ById query or a *[]Swap for a
+// List query. typed models (e.g. *typed.Pool) are supported.
+func (r *BatchResults) Decode(i int, out any) error {
+	if i < 0 || i >= len(r.values) {
+		return fmt.Errorf("batch error: no result at index %d", i)
+	}
+	if r.values[i] == nil {
+		return fmt.Errorf("batch error: result %d not found", i)
+	}
+	return decodeResponse(r.values[i], out)
+}
+
+// ExecuteBatch sends the queries in the batch, splitting them into requests of at most opts.MaxPerRequest queries
+func (c *Client) ExecuteBatch(ctx context.Context, b *Batch, opts *BatchOptions) (*BatchResults, error) {
+	maxPerRequest := defaultMaxPerRequest
+	if opts != nil && opts.MaxPerRequest != 0 {
+		maxPerRequest = opts.MaxPerRequest
+	}
+	if maxPerRequest < 0 {
+		return nil, errors.New("batch error: MaxPerRequest must not be negative")
+	}
+
+	for _, item := range b.items {
+		if item.err != nil {
+			return nil, item.err
+		}
+	}
+
+	results := &BatchResults{values: make([]any, len(b.items))}
+	for start := 0; start < len(b.items); start += maxPerRequest {
+		end := min(start+maxPerRequest, len(b.items))
+
+		req, aliases, err := assembleBatchQuery(b.items[start:end], start)
+		if err != nil {
+			return nil, err
+		}
+
+		// the batch is only cached as long as its least cacheable query
+		policy := cachePinned
+		for _, item := range b.items[start:end] {
+			policy = min(policy, requestCachePolicy(item.opts))
+		}
+
+		var resp map[string]any
+		if err := c.run(ctx, req, policy, &resp); err != nil {
+			return nil, err
+		}
+		for i, alias := range aliases {
+			results.values[start+i] = resp[alias]
+		}
+	}
+
+	return results, nil
+}
+
+// builds a single request selecting every item as an aliased root field e.g. `q3: pool(id: $q3_id) {...}`. each
+// item's variables are prefixed with its alias so they can't collide. offset is the index of the first item in the
+// batch, used to name the aliases.
+func assembleBatchQuery(items []batchItem, offset int) (*graphql.Request, []string, error) {
+	var decls []string
+	var body []string
+	aliases := make([]string, len(items))
+	prepared := make([]*RequestOptions, len(items))
+
+	for i, item := range items {
+		alias := fmt.Sprintf("q%d", offset+i)
+		model, opts, err := prepareQuery(item.queryType, item.model, item.opts)
+		if err != nil {
+			return nil, nil, fmt.Errorf("batch error: query %d: %w", offset+i, err)
+		}
+		itemDecls, field, err := assembleRootField(item.queryType, model, opts, alias, alias+"_")
+		if err != nil {
+			return nil, nil, fmt.Errorf("batch error: query %d: %w", offset+i, err)
+		}
+
+		aliases[i] = alias
+		prepared[i] = opts
+		decls = append(decls, itemDecls...)
+		body = append(body, field...)
+	}
+
+	query := strings.Join(slices.Concat(
+		[]string{fmt.Sprintf("query batch(%s) {", strings.Join(decls, ", "))},
+		body,
+		[]string{"}"},
+	), "\n")
+
+	req := graphql.NewRequest(query)
+	for i, item := range items {
+		setQueryVars(req, item.queryType, item.id, aliases[i]+"_", prepared[i])
+	}
+	return req, aliases, nil
+}
+
+// pools returned by GetPoolsByIds
+type PoolsByIdsResponse struct {
+	Pools   []Pool   // pools that were found, in the order of the given ids
+	Missing []string // ids that weren't found
+}
+
+// tokens returned by GetTokensByIds
+type TokensByIdsResponse struct {
+	Tokens  []Token  // tokens that were found, in the order of the given ids
+	Missing []string // ids that weren't found
+}
+
+// GetPoolsByIds fetches pools by id with batched ById queries (see ExecuteBatch)
+func (c *Client) GetPoolsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*PoolsByIdsResponse, error) {
+	pools, missing, err := getByIds[Pool](ctx, c, "pool", ids, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &PoolsByIdsResponse{Pools: pools, Missing: missing}, nil
+}
+
+// GetTokensByIds fetches tokens by id with batched ById queries (see ExecuteBatch)
+func (c *Client) GetTokensByIds(ctx context.Context, ids []string, opts *RequestOptions) (*TokensByIdsResponse, error) {
+	tokens, missing, err := getByIds[Token](ctx, c, "token", ids, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &TokensByIdsResponse{Tokens: tokens, Missing: missing}, nil
+}
+
+// fetches entities by id in a batch, returning the entities found in the order of ids and the ids that weren't
+// found. duplicate ids are only queried once.
+func getByIds[T any](ctx context.Context, c *Client, model string, ids []string, opts *RequestOptions) ([]T, []string, error) {
+	batch := NewBatch()
+	index := map[string]int{}
+	for _, id := range ids {
+		if _, ok := index[id]; !ok {
+			index[id] = batch.GetById(model, id, opts)
+		}
+	}
+
+	results, err := c.ExecuteBatch(ctx, batch, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	found := make([]T, 0, len(ids))
+	var missing []string
+	seen := map[string]bool{}
+	for _, id := range ids {
+		i := index[id]
+		if !results.Found(i) {
+			if !seen[id] {
+				seen[id] = true
+				missing = append(missing, id)
+			}
+			continue
+		}
+		var entity T
+		if err := results.Decode(i, &entity); err != nil {
+			return nil, nil, err
+		}
+		found = append(found, entity)
+	}
+	return found, missing, nil
+}
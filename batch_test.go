@@ -0,0 +1,162 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAssembleBatchQuery(t *testing.T) {
+	t.Run("when models are mixed", func(t *testing.T) {
+		batch := NewBatch()
+		batch.GetById("pool", "0xpool", &RequestOptions{IncludeFields: []string{"id", "token0.symbol"}})
+		batch.List("swap", &RequestOptions{IncludeFields: []string{"id"}, First: 5, Where: Where{"pool": "0xpool"}})
+
+		req, aliases, err := assembleBatchQuery(batch.items, 0)
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"q0", "q1"}, aliases)
+		assert.Equal(t, strings.Join([]string{
+			"query batch($q0_id: ID!, $q1_first: Int!, $q1_skip: Int!, $q1_orderBy: String!, $q1_orderDir: String!, $q1_where: Swap_filter) {",
+			"	q0: pool(id: $q0_id) {",
+			"		id",
+			"		token0 {",
+			"			symbol",
+			"		}",
+			"	}",
+			"	q1: swaps(first: $q1_first, skip: $q1_skip, orderBy: $q1_orderBy, orderDirection: $q1_orderDir, where: $q1_where) {",
+			"		id",
+			"	}",
+			"}",
+		}, "\n"), req.Query())
+		assert.Equal(t, "0xpool", req.Vars()["q0_id"])
+		assert.Equal(t, 5, req.Vars()["q1_first"])
+		assert.Equal(t, Where{"pool": "0xpool"}, req.Vars()["q1_where"])
+	})
+
+	t.Run("when a query has a derived where clause", func(t *testing.T) {
+		batch := NewBatch()
+		batch.GetById("pool", "0xpool", &RequestOptions{
+			IncludeFields: []string{"id", "swaps.id"},
+			Derived:       map[string]DerivedOptions{"swaps": {First: 2, Where: Where{"origin": "0xorigin"}}},
+		})
+
+		req, _, err := assembleBatchQuery(batch.items, 4)
+		assert.Nil(t, err)
+		assert.Equal(t, strings.Join([]string{
+			"query batch($q4_id: ID!, $q4_swaps_where: Swap_filter) {",
+			"	q4: pool(id: $q4_id) {",
+			"		id",
+			"		swaps(first: 2, where: $q4_swaps_where) {",
+			"			id",
+			"		}",
+			"	}",
+			"}",
+		}, "\n"), req.Query())
+		assert.Equal(t, Where{"origin": "0xorigin"}, req.Vars()["q4_swaps_where"])
+	})
+
+	t.Run("when a query is invalid", func(t *testing.T) {
+		batch := NewBatch()
+		batch.GetById("pool", "0xpool", &RequestOptions{IncludeFields: []string{"nope"}})
+
+		_, _, err := assembleBatchQuery(batch.items, 3)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "batch error: query 3")
+	})
+}
+
+func TestExecuteBatch(t *testing.T) {
+	t.Run("when the batch is split", func(t *testing.T) {
+		server, requests := getBatchTestServer(t, map[string]bool{"0xa": true, "0xc": true})
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		batch := NewBatch()
+		for _, id := range []string{"0xa", "0xb", "0xc"} {
+			batch.GetById("pool", id, &RequestOptions{IncludeFields: []string{"id"}})
+		}
+		results, err := client.ExecuteBatch(context.Background(), batch, &BatchOptions{MaxPerRequest: 2})
+		assert.Nil(t, err)
+		assert.Equal(t, 2, *requests)
+		assert.Equal(t, 3, results.Len())
+		assert.True(t, results.Found(0))
+		assert.False(t, results.Found(1))
+		assert.True(t, results.Found(2))
+
+		var pool Pool
+		assert.Nil(t, results.Decode(2, &pool))
+		assert.Equal(t, "0xc", pool.ID)
+		assert.NotNil(t, results.Decode(1, &pool))
+	})
+
+	t.Run("when a model is unrecognized", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		batch := NewBatch()
+		batch.GetById("nope", "0xa", nil)
+		_, err := client.ExecuteBatch(context.Background(), batch, nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized model (nope)")
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "pool")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		batch := NewBatch()
+		batch.GetById("pool", "0xa", nil)
+		_, err := client.ExecuteBatch(context.Background(), batch, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetPoolsByIds(t *testing.T) {
+	server, requests := getBatchTestServer(t, map[string]bool{"0xa": true, "0xc": true})
+	defer server.Close()
+
+	client := NewClient(server.URL, nil)
+
+	resp, err := client.GetPoolsByIds(context.Background(), []string{"0xc", "0xb", "0xa", "0xc"}, &RequestOptions{IncludeFields: []string{"id"}})
+	assert.Nil(t, err)
+	assert.Equal(t, 1, *requests)
+	assert.Len(t, resp.Pools, 3)
+	assert.Equal(t, "0xc", resp.Pools[0].ID)
+	assert.Equal(t, "0xa", resp.Pools[1].ID)
+	assert.Equal(t, "0xc", resp.Pools[2].ID)
+	assert.Equal(t, []string{"0xb"}, resp.Missing)
+}
+
+// answers aliased ById queries for pools, returning null for ids that don't exist
+func getBatchTestServer(t *testing.T, existing map[string]bool) (*httptest.Server, *int) {
+	aliasRegexp := regexp.MustCompile(`(q\d+): pool\(id: \$(\w+)\)`)
+	requests := new(int)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests++
+		var body struct {
+			Query     string
+			Variables map[string]any
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		data := map[string]any{}
+		for _, match := range aliasRegexp.FindAllStringSubmatch(body.Query, -1) {
+			id := body.Variables[match[2]].(string)
+			if existing[id] {
+				data[match[1]] = map[string]any{"id": id}
+			} else {
+				data[match[1]] = nil
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	return server, requests
+}
@@ -0,0 +1,158 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+)
+
+// number of List*ByIds chunks requested at once
+const listByIdsConcurrency int = 4
+
+// results of a List*ByIds query
+type ListByIdsResponse[T any] struct {
+	Results []T      // entities that were found, in the order of the given ids
+	Missing []string // ids that weren't found (or didn't match opts.Where)
+}
+
+// fetches entities with `id_in` filters, requesting chunks of opts.First ids (1000 by default) concurrently.
+// results are returned in the order of ids, with duplicate ids only requested once.
+func listByIds[T any](ctx context.Context, c *Client, model modelFields, ids []string, opts *RequestOptions) (*ListByIdsResponse[T], error) {
+	var baseOpts RequestOptions
+	if opts != nil {
+		baseOpts = *opts
+	}
+	if baseOpts.Skip != 0 || baseOpts.OrderBy != "" || baseOpts.OrderDir != "" {
+		return nil, errors.New("request options error: Skip, OrderBy and OrderDir are not supported by List*ByIds queries")
+	}
+	if baseOpts.First < 0 || baseOpts.First > 1000 {
+		return nil, errors.New("request options error: First is too large (must be <= 1000)")
+	}
+	chunkSize := baseOpts.First
+	if chunkSize == 0 {
+		chunkSize = 1000
+	}
+	if len(baseOpts.IncludeFields) > 0 && !slices.Contains(baseOpts.IncludeFields, "*") && !slices.Contains(baseOpts.IncludeFields, "id") {
+		// ids are needed to match rows back to the input
+		baseOpts.IncludeFields = append(slices.Clone(baseOpts.IncludeFields), "id")
+	}
+
+	var unique []string
+	seen := map[string]bool{}
+	for _, id := range ids {
+		if !seen[id] {
+			seen[id] = true
+			unique = append(unique, id)
+		}
+	}
+	chunks := slices.Collect(slices.Chunk(unique, chunkSize))
+
+	rows := make(map[string]any, len(unique))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	errs := make([]error, len(chunks))
+	sem := make(chan struct{}, listByIdsConcurrency)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	for i, chunk := range chunks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				errs[i] = ctx.Err()
+				return
+			}
+
+			chunkRows, err := listByIdsChunk(ctx, c, model, chunk, baseOpts)
+			if err != nil {
+				errs[i] = err
+				cancel()
+				return
+			}
+			mu.Lock()
+			defer mu.Unlock()
+			for id, row := range chunkRows {
+				rows[id] = row
+			}
+		}()
+	}
+	wg.Wait()
+
+	// prefer the error that caused the cancellation over the cancellations themselves
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, context.Canceled) {
+			return nil, err
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, err
+	}
+
+	resp := &ListByIdsResponse[T]{Results: make([]T, 0, len(ids))}
+	missing := map[string]bool{}
+	for _, id := range ids {
+		row, ok := rows[id]
+		if !ok {
+			if !missing[id] {
+				missing[id] = true
+				resp.Missing = append(resp.Missing, id)
+			}
+			continue
+		}
+		var entity T
+		if err := decodeResponse(row, &entity); err != nil {
+			return nil, err
+		}
+		resp.Results = append(resp.Results, entity)
+	}
+	return resp, nil
+}
+
+// requests a single chunk of ids, returning the raw rows keyed by id
+func listByIdsChunk(ctx context.Context, c *Client, model modelFields, ids []string, opts RequestOptions) (map[string]any, error) {
+	// combined with `and` rather than merged, so a top-level `or` or an `id_in` in the caller's filter is kept intact
+	idFilter := In("id", ids)
+	if len(opts.Where) > 0 {
+		opts.Where = And(opts.Where, idFilter)
+	} else {
+		opts.Where = idFilter
+	}
+	opts.First = len(ids)
+
+	req, err := constructListQuery(model, &opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]any
+	if err := c.run(ctx, req, requestCachePolicy(&opts), &resp); err != nil {
+		return nil, err
+	}
+	if hasStalenessGuard(&opts) {
+		var meta MetaResponse
+		if err := decodeResponse(resp, &meta); err != nil {
+			return nil, err
+		}
+		if err := c.checkStaleness(ctx, meta.Meta, &opts); err != nil {
+			return nil, err
+		}
+	}
+
+	rawRows, _ := resp[pluralizeModelName(model.name)].([]any)
+	rows := make(map[string]any, len(rawRows))
+	for _, rawRow := range rawRows {
+		row, ok := rawRow.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("unexpected row in response (%v)", rawRow)
+		}
+		id, _ := row["id"].(string)
+		rows[id] = row
+	}
+	return rows, nil
+}
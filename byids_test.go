@@ -0,0 +1,133 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serves `pools` queries, returning the ids in `id_in` that exist in reverse order
+func getByIdsTestServer(t *testing.T, existing map[string]bool) (*httptest.Server, *[][]string) {
+	var mu sync.Mutex
+	var chunks [][]string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Variables struct {
+				First int            `json:"first"`
+				Where map[string]any `json:"where"`
+			} `json:"variables"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Errorf("failed to decode request body: %v", err)
+		}
+
+		// the caller's filter and the ids are combined with `and`
+		where := body.Variables.Where
+		if and, ok := where["and"].([]any); ok {
+			where = and[1].(map[string]any)
+		}
+		var ids []string
+		for _, id := range where["id_in"].([]any) {
+			ids = append(ids, id.(string))
+		}
+		assert.Equal(t, len(ids), body.Variables.First)
+		mu.Lock()
+		chunks = append(chunks, ids)
+		mu.Unlock()
+
+		pools := []map[string]any{}
+		for i := len(ids) - 1; i >= 0; i-- {
+			if existing[ids[i]] {
+				pools = append(pools, map[string]any{"id": ids[i], "liquidity": "1"})
+			}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"pools": pools}})
+	}))
+	return server, &chunks
+}
+
+func TestListByIds(t *testing.T) {
+	t.Run("when ids are chunked", func(t *testing.T) {
+		server, chunks := getByIdsTestServer(t, map[string]bool{"0xa": true, "0xc": true, "0xd": true})
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		ids := []string{"0xd", "0xa", "0xb", "0xc", "0xa", "0xe"}
+		resp, err := client.ListPoolsByIds(context.Background(), ids, &RequestOptions{IncludeFields: []string{"liquidity"}, First: 2})
+		assert.Nil(t, err)
+		assert.Len(t, *chunks, 3)
+		for _, chunk := range *chunks {
+			assert.LessOrEqual(t, len(chunk), 2)
+		}
+
+		var found []string
+		for _, pool := range resp.Results {
+			found = append(found, pool.ID)
+			assert.Equal(t, "1", pool.Liquidity)
+		}
+		assert.Equal(t, []string{"0xd", "0xa", "0xc", "0xa"}, found)
+		assert.Equal(t, []string{"0xb", "0xe"}, resp.Missing)
+	})
+
+	t.Run("when opts are invalid", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		_, err := client.ListPoolsByIds(context.Background(), []string{"0xa"}, &RequestOptions{Skip: 10})
+		assert.NotNil(t, err)
+
+		_, err = client.ListPoolsByIds(context.Background(), []string{"0xa"}, &RequestOptions{First: 1001})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a request fails", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		_, err := client.ListPoolsByIds(context.Background(), []string{"0xa", "0xb", "0xc"}, &RequestOptions{First: 1})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "500")
+	})
+}
+
+func TestListByIdsChunkWhere(t *testing.T) {
+	t.Run("when where already filters id_in", func(t *testing.T) {
+		var where map[string]any
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			var body struct {
+				Variables struct {
+					Where map[string]any `json:"where"`
+				} `json:"variables"`
+			}
+			json.NewDecoder(r.Body).Decode(&body)
+			where = body.Variables.Where
+			w.Write([]byte(`{"data":{"pools":[]}}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		resp, err := client.ListPoolsByIds(context.Background(), []string{"0xa"}, &RequestOptions{Where: Where{"id_in": []string{"0xb"}}})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"0xa"}, resp.Missing)
+		assert.Len(t, where["and"], 2)
+	})
+
+	t.Run("when where is a top-level or", func(t *testing.T) {
+		server, chunks := getByIdsTestServer(t, map[string]bool{"0xa": true})
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		where := Or(Eq("feeTier", "500"), Eq("feeTier", "3000"))
+		resp, err := client.ListPoolsByIds(context.Background(), []string{"0xa", "0xb"}, &RequestOptions{Where: where})
+		assert.Nil(t, err)
+		assert.Equal(t, []string{"0xb"}, resp.Missing)
+		assert.Equal(t, [][]string{{"0xa", "0xb"}}, *chunks)
+	})
+}
@@ -0,0 +1,297 @@
+package unigraphclient
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+// default interval between checks of the latest indexed block, for CacheOptions.InvalidateOnNewBlock
+const defaultBlockCheckInterval time.Duration = 5 * time.Second
+
+// storage for cached responses, keyed by a hash of the endpoint url, query and variables. implementations must be
+// safe for concurrent use.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// a cached response
+type CacheEntry struct {
+	Data     json.RawMessage `json:"data"`     // response data
+	Pinned   bool            `json:"pinned"`   // true if the query was pinned to a block with Block or BlockHash (never invalidated)
+	Block    int             `json:"block"`    // latest block indexed by the subgraph when the entry was stored. only set for unpinned entries with InvalidateOnNewBlock.
+	StoredAt time.Time       `json:"storedAt"` // time the entry was stored
+}
+
+// options for ClientOptions.Cache
+type CacheOptions struct {
+	TTL                  time.Duration // how long responses for the latest block are cached. 0 means no expiry.
+	InvalidateOnNewBlock bool          // invalidate responses for the latest block when the subgraph indexes a new block (checked with _meta).
+	BlockCheckInterval   time.Duration // minimum interval between checks of the latest indexed block. `5s` is the default.
+}
+
+// state of a client's cache
+type responseCache struct {
+	cache Cache
+	opts  CacheOptions
+
+	mu        sync.Mutex
+	block     int // latest indexed block seen
+	checkedAt time.Time
+	checking  chan struct{} // closed when the block check in flight completes. nil when no check is running.
+}
+
+// how the response to a request can be cached. ordered from least to most cacheable, so a request of several queries
+// (e.g. a batch) can be cached as long as the minimum of their policies.
+type cachePolicy int
+
+const (
+	noCache     cachePolicy = iota // never cached: indexing status (_meta), including staleness guards, must always be fresh
+	cacheLatest                    // data at the latest block: cached with CacheOptions.TTL or InvalidateOnNewBlock
+	cachePinned                    // data pinned to a block with Block or BlockHash, which never changes
+)
+
+// returns the cache policy of a query built from opts
+func requestCachePolicy(opts *RequestOptions) cachePolicy {
+	switch {
+	case hasStalenessGuard(opts):
+		return noCache
+	case opts != nil && (opts.Block != 0 || opts.BlockHash != ""):
+		return cachePinned
+	}
+	return cacheLatest
+}
+
+// returns the cache key of req, scoped to the client's primary endpoint so clients of different subgraphs can share a cache
+func (c *Client) cacheKey(req *graphql.Request) string {
+	h := sha256.Sum256([]byte(c.hostUrl + "\x00" + req.Hash()))
+	return hex.EncodeToString(h[:])
+}
+
+// returns the cached response to req, or false if there isn't a valid one
+func (c *Client) cacheGet(ctx context.Context, key string) (json.RawMessage, bool) {
+	entry, ok := c.cache.cache.Get(key)
+	if !ok {
+		return nil, false
+	}
+	if entry.Pinned {
+		return entry.Data, true
+	}
+
+	opts := c.cache.opts
+	if opts.TTL > 0 && time.Since(entry.StoredAt) > opts.TTL {
+		c.cache.cache.Delete(key)
+		return nil, false
+	}
+	if opts.InvalidateOnNewBlock {
+		block, err := c.cachedBlock(ctx)
+		if err != nil || block != entry.Block {
+			c.cache.cache.Delete(key)
+			return nil, false
+		}
+	}
+	return entry.Data, true
+}
+
+// stores a response, unless it's for the latest block and neither TTL nor InvalidateOnNewBlock are set
+func (c *Client) cacheSet(ctx context.Context, key string, data json.RawMessage, pinned bool) {
+	entry := &CacheEntry{Data: data, Pinned: pinned, StoredAt: time.Now()}
+	if !pinned {
+		opts := c.cache.opts
+		if opts.TTL <= 0 && !opts.InvalidateOnNewBlock {
+			return
+		}
+		if opts.InvalidateOnNewBlock {
+			block, err := c.cachedBlock(ctx)
+			if err != nil {
+				return
+			}
+			entry.Block = block
+		}
+	}
+	c.cache.cache.Set(key, entry)
+}
+
+// returns the latest indexed block, checking _meta at most once per BlockCheckInterval
+func (c *Client) cachedBlock(ctx context.Context) (int, error) {
+	interval := c.cache.opts.BlockCheckInterval
+	if interval <= 0 {
+		interval = defaultBlockCheckInterval
+	}
+
+	for {
+		c.cache.mu.Lock()
+		if !c.cache.checkedAt.IsZero() && time.Since(c.cache.checkedAt) < interval {
+			block := c.cache.block
+			c.cache.mu.Unlock()
+			return block, nil
+		}
+		if checking := c.cache.checking; checking != nil {
+			// another lookup is checking the block, so wait for its result (or check again if it failed)
+			c.cache.mu.Unlock()
+			select {
+			case <-checking:
+				continue
+			case <-ctx.Done():
+				return 0, ctx.Err()
+			}
+		}
+		checking := make(chan struct{})
+		c.cache.checking = checking
+		c.cache.mu.Unlock()
+
+		// the lock isn't held during the query, so lookups that don't need the block aren't held up by it
+		meta, err := c.GetMeta(ctx)
+
+		c.cache.mu.Lock()
+		c.cache.checking = nil
+		if err == nil {
+			c.cache.block = meta.Meta.Block.Number
+			c.cache.checkedAt = time.Now()
+		}
+		c.cache.mu.Unlock()
+		close(checking)
+
+		if err != nil {
+			return 0, err
+		}
+		return meta.Meta.Block.Number, nil
+	}
+}
+
+// in-memory Cache that evicts the least recently used entries
+type MemoryCache struct {
+	maxEntries int
+
+	mu      sync.Mutex
+	order   *list.List // most recently used at the front
+	entries map[string]*list.Element
+}
+
+type memoryCacheItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewMemoryCache returns an in-memory LRU cache holding at most maxEntries responses (0 means unlimited)
+func NewMemoryCache(maxEntries int) *MemoryCache {
+	return &MemoryCache{
+		maxEntries: maxEntries,
+		order:      list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+func (m *MemoryCache) Get(key string) (*CacheEntry, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	el, ok := m.entries[key]
+	if !ok {
+		return nil, false
+	}
+	m.order.MoveToFront(el)
+	return el.Value.(*memoryCacheItem).entry, true
+}
+
+func (m *MemoryCache) Set(key string, entry *CacheEntry) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		el.Value.(*memoryCacheItem).entry = entry
+		m.order.MoveToFront(el)
+		return
+	}
+	m.entries[key] = m.order.PushFront(&memoryCacheItem{key: key, entry: entry})
+	if m.maxEntries > 0 && m.order.Len() > m.maxEntries {
+		oldest := m.order.Back()
+		m.order.Remove(oldest)
+		delete(m.entries, oldest.Value.(*memoryCacheItem).key)
+	}
+}
+
+func (m *MemoryCache) Delete(key string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if el, ok := m.entries[key]; ok {
+		m.order.Remove(el)
+		delete(m.entries, key)
+	}
+}
+
+// Len returns the number of cached responses
+func (m *MemoryCache) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.order.Len()
+}
+
+// Cache storing each response as a json file in a directory, so responses survive restarts
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a cache storing responses in dir, creating it if needed
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache error: dir is required")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+func (d *DiskCache) path(key string) string {
+	return filepath.Join(d.dir, key+".json")
+}
+
+func (d *DiskCache) Get(key string) (*CacheEntry, bool) {
+	b, err := os.ReadFile(d.path(key))
+	if err != nil {
+		return nil, false
+	}
+	var entry CacheEntry
+	if err := json.Unmarshal(b, &entry); err != nil {
+		// unreadable entries are treated as missing
+		return nil, false
+	}
+	return &entry, true
+}
+
+func (d *DiskCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	// write to a temp file first so concurrent readers never see a partial entry
+	tmp, err := os.CreateTemp(d.dir, key+".*.tmp")
+	if err != nil {
+		return
+	}
+	_, err = tmp.Write(b)
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), d.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+func (d *DiskCache) Delete(key string) {
+	os.Remove(d.path(key))
+}
@@ -0,0 +1,240 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// serves factory and _meta queries, counting factory queries. the indexed block is read from block.
+func getCacheTestServer(t *testing.T, block *atomic.Int64) (*httptest.Server, *atomic.Int64) {
+	var requests atomic.Int64
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		data := map[string]any{}
+		if strings.Contains(body.Query, "_meta") {
+			data["_meta"] = map[string]any{"block": map[string]any{"number": block.Load()}}
+		}
+		if strings.Contains(body.Query, "factory(") {
+			requests.Add(1)
+			data["factory"] = map[string]any{"id": "test", "poolCount": "1"}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+	return server, &requests
+}
+
+func TestClientCache(t *testing.T) {
+	ctx := context.Background()
+	var block atomic.Int64
+	block.Store(100)
+
+	t.Run("when the request is pinned to a block", func(t *testing.T) {
+		server, requests := getCacheTestServer(t, &block)
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{Cache: NewMemoryCache(10)})
+		for range 3 {
+			resp, err := client.GetFactoryById(ctx, "test", &RequestOptions{IncludeFields: []string{"*"}, Block: 50})
+			assert.Nil(t, err)
+			assert.Equal(t, "test", resp.Factory.ID)
+		}
+		assert.Equal(t, int64(1), requests.Load())
+
+		_, err := client.GetFactoryById(ctx, "test", &RequestOptions{IncludeFields: []string{"*"}, Block: 51})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("when the request is for the latest block without CacheOptions", func(t *testing.T) {
+		server, requests := getCacheTestServer(t, &block)
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{Cache: NewMemoryCache(10)})
+		for range 2 {
+			_, err := client.GetFactoryById(ctx, "test", nil)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("when latest responses expire with a TTL", func(t *testing.T) {
+		server, requests := getCacheTestServer(t, &block)
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{
+			Cache:        NewMemoryCache(10),
+			CacheOptions: &CacheOptions{TTL: 50 * time.Millisecond},
+		})
+		for range 2 {
+			_, err := client.GetFactoryById(ctx, "test", nil)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int64(1), requests.Load())
+
+		time.Sleep(60 * time.Millisecond)
+		_, err := client.GetFactoryById(ctx, "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("when latest responses are invalidated by a new block", func(t *testing.T) {
+		server, requests := getCacheTestServer(t, &block)
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{
+			Cache:        NewMemoryCache(10),
+			CacheOptions: &CacheOptions{InvalidateOnNewBlock: true, BlockCheckInterval: time.Nanosecond},
+		})
+		for range 2 {
+			_, err := client.GetFactoryById(ctx, "test", nil)
+			assert.Nil(t, err)
+		}
+		assert.Equal(t, int64(1), requests.Load())
+
+		block.Add(1)
+		_, err := client.GetFactoryById(ctx, "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("when the request has a staleness guard", func(t *testing.T) {
+		server, requests := getCacheTestServer(t, &block)
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{Cache: NewMemoryCache(10)})
+		for range 2 {
+			_, err := client.GetFactoryById(ctx, "test", &RequestOptions{Block: 50, MaxAge: time.Hour})
+			assert.NotNil(t, err)
+		}
+		assert.Equal(t, int64(2), requests.Load())
+	})
+
+	t.Run("when the request fails", func(t *testing.T) {
+		var requests atomic.Int64
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests.Add(1)
+			w.Write([]byte(`{"errors":[{"message":"boom"}]}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{Cache: NewMemoryCache(10)})
+		for range 2 {
+			_, err := client.GetFactoryById(ctx, "test", &RequestOptions{Block: 50})
+			assert.NotNil(t, err)
+		}
+		assert.Equal(t, int64(2), requests.Load())
+	})
+}
+
+func TestRequestCachePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		opts   *RequestOptions
+		policy cachePolicy
+	}{
+		{"when the query is for the latest block", nil, cacheLatest},
+		{"when the query is pinned by number", &RequestOptions{Block: 10}, cachePinned},
+		{"when the query is pinned by hash", &RequestOptions{BlockHash: "0x" + strings.Repeat("ab", 32)}, cachePinned},
+		{"when the query has a minimum block", &RequestOptions{BlockNumberGte: 10}, cacheLatest},
+		{"when the query has a staleness guard", &RequestOptions{Block: 10, MaxAge: time.Minute}, noCache},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.policy, requestCachePolicy(tt.opts))
+		})
+	}
+
+	batchTests := []struct {
+		name     string
+		blocks   []int
+		requests int64
+	}{
+		{"when every query in a batch is pinned", []int{10, 20}, 1},
+		{"when only some queries in a batch are pinned", []int{10, 0}, 2},
+	}
+	for _, tt := range batchTests {
+		t.Run(tt.name, func(t *testing.T) {
+			var block atomic.Int64
+			server, requests := getCacheTestServer(t, &block)
+			defer server.Close()
+
+			client := NewClient(server.URL, &ClientOptions{Cache: NewMemoryCache(10)})
+			for range 2 {
+				batch := NewBatch()
+				for _, block := range tt.blocks {
+					batch.GetById("factory", "test", &RequestOptions{IncludeFields: []string{"id"}, Block: block})
+				}
+				_, err := client.ExecuteBatch(context.Background(), batch, nil)
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.requests, requests.Load())
+		})
+	}
+}
+
+func TestMemoryCache(t *testing.T) {
+	t.Run("when the cache is full", func(t *testing.T) {
+		cache := NewMemoryCache(2)
+		cache.Set("a", &CacheEntry{Data: json.RawMessage(`1`)})
+		cache.Set("b", &CacheEntry{Data: json.RawMessage(`2`)})
+		cache.Get("a")
+		cache.Set("c", &CacheEntry{Data: json.RawMessage(`3`)})
+
+		assert.Equal(t, 2, cache.Len())
+		_, ok := cache.Get("b")
+		assert.False(t, ok)
+		entry, ok := cache.Get("a")
+		assert.True(t, ok)
+		assert.Equal(t, json.RawMessage(`1`), entry.Data)
+	})
+
+	t.Run("when an entry is deleted", func(t *testing.T) {
+		cache := NewMemoryCache(0)
+		cache.Set("a", &CacheEntry{})
+		cache.Delete("a")
+		_, ok := cache.Get("a")
+		assert.False(t, ok)
+	})
+}
+
+func TestDiskCache(t *testing.T) {
+	t.Run("when entries are stored", func(t *testing.T) {
+		dir := t.TempDir()
+		cache, err := NewDiskCache(dir)
+		assert.Nil(t, err)
+
+		storedAt := time.Now().Truncate(time.Second)
+		cache.Set("a", &CacheEntry{Data: json.RawMessage(`{"x":1}`), Pinned: true, Block: 7, StoredAt: storedAt})
+
+		// a new cache in the same dir sees the entry
+		reopened, err := NewDiskCache(dir)
+		assert.Nil(t, err)
+		entry, ok := reopened.Get("a")
+		assert.True(t, ok)
+		assert.JSONEq(t, `{"x":1}`, string(entry.Data))
+		assert.True(t, entry.Pinned)
+		assert.Equal(t, 7, entry.Block)
+		assert.True(t, storedAt.Equal(entry.StoredAt))
+
+		reopened.Delete("a")
+		_, ok = cache.Get("a")
+		assert.False(t, ok)
+	})
+
+	t.Run("when dir is empty", func(t *testing.T) {
+		_, err := NewDiskCache("")
+		assert.NotNil(t, err)
+	})
+}
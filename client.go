@@ -2,14 +2,27 @@ package unigraphclient
 
 import (
 	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
 	"net/http"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+	"github.com/emersonmacro/go-uniswap-subgraph-client/typed"
 
 	"github.com/mitchellh/mapstructure"
 )
 
 func NewClient(url string, opts *ClientOptions) *Client {
+	return newClient([]string{url}, opts)
+}
+
+func newClient(urls []string, opts *ClientOptions) *Client {
 	if opts == nil {
 		opts = &ClientOptions{}
 	}
@@ -18,333 +31,250 @@ func NewClient(url string, opts *ClientOptions) *Client {
 		opts.HttpClient = http.DefaultClient
 	}
 
-	var gqlClient *graphql.Client
-
-	if opts.CloseReq {
-		gqlClient = graphql.NewClient(url, graphql.WithHTTPClient(opts.HttpClient), graphql.ImmediatelyCloseReqBody())
-	} else {
-		gqlClient = graphql.NewClient(url, graphql.WithHTTPClient(opts.HttpClient))
-	}
-
-	return &Client{
-		hostUrl:   url,
-		GqlClient: gqlClient,
+	logger := opts.Logger
+	if logger == nil {
+		logger = discardLogger
 	}
-}
 
-func (c *Client) GetFactoryById(ctx context.Context, id string, opts *RequestOptions) (*FactoryResponse, error) {
-	req, err := constructByIdQuery(id, FactoryFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, FactoryResponse{}, c)
-}
-
-func (c *Client) ListFactories(ctx context.Context, opts *RequestOptions) (*ListFactoriesResponse, error) {
-	req, err := constructListQuery(FactoryFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListFactoriesResponse{}, c)
-}
-
-func (c *Client) GetPoolById(ctx context.Context, id string, opts *RequestOptions) (*PoolResponse, error) {
-	req, err := constructByIdQuery(id, PoolFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, PoolResponse{}, c)
-}
-
-func (c *Client) ListPools(ctx context.Context, opts *RequestOptions) (*ListPoolsResponse, error) {
-	req, err := constructListQuery(PoolFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListPoolsResponse{}, c)
-}
-
-func (c *Client) GetTokenById(ctx context.Context, id string, opts *RequestOptions) (*TokenResponse, error) {
-	req, err := constructByIdQuery(id, TokenFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, TokenResponse{}, c)
-}
-
-func (c *Client) ListTokens(ctx context.Context, opts *RequestOptions) (*ListTokensResponse, error) {
-	req, err := constructListQuery(TokenFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListTokensResponse{}, c)
-}
-
-func (c *Client) GetBundleById(ctx context.Context, id string, opts *RequestOptions) (*BundleResponse, error) {
-	req, err := constructByIdQuery(id, BundleFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, BundleResponse{}, c)
-}
-
-func (c *Client) ListBundles(ctx context.Context, opts *RequestOptions) (*ListBundlesResponse, error) {
-	req, err := constructListQuery(BundleFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListBundlesResponse{}, c)
-}
-
-func (c *Client) GetTickById(ctx context.Context, id string, opts *RequestOptions) (*TickResponse, error) {
-	req, err := constructByIdQuery(id, TickFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, TickResponse{}, c)
-}
-
-func (c *Client) ListTicks(ctx context.Context, opts *RequestOptions) (*ListTicksResponse, error) {
-	req, err := constructListQuery(TickFields, opts)
-	if err != nil {
-		return nil, err
+	gqlOpts := []graphql.ClientOption{graphql.WithHTTPClient(opts.HttpClient)}
+	if opts.CloseReq {
+		gqlOpts = append(gqlOpts, graphql.ImmediatelyCloseReqBody())
 	}
-	return executeRequestAndConvert(ctx, req, ListTicksResponse{}, c)
-}
-
-func (c *Client) GetPositionById(ctx context.Context, id string, opts *RequestOptions) (*PositionResponse, error) {
-	req, err := constructByIdQuery(id, PositionFields, opts)
-	if err != nil {
-		return nil, err
+	failoverPolicy := graphql.DefaultRetryPolicy()
+	if opts.RetryPolicy != nil {
+		gqlOpts = append(gqlOpts, graphql.WithRetryPolicy(*opts.RetryPolicy))
+		failoverPolicy = *opts.RetryPolicy
 	}
-	return executeRequestAndConvert(ctx, req, PositionResponse{}, c)
-}
 
-func (c *Client) ListPositions(ctx context.Context, opts *RequestOptions) (*ListPositionsResponse, error) {
-	req, err := constructListQuery(PositionFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListPositionsResponse{}, c)
-}
+	endpoints := make([]*endpoint, len(urls))
+	for i, url := range urls {
+		var limiter *limiter
+		endpointOpts := gqlOpts
+		if opts.RateLimit != nil {
+			limiter = sharedLimiter(url, *opts.RateLimit)
+			endpointOpts = append(slices.Clip(gqlOpts), graphql.WithAttemptHook(limiter.beforeAttempt))
+		}
 
-func (c *Client) GetTransactionById(ctx context.Context, id string, opts *RequestOptions) (*TransactionResponse, error) {
-	req, err := constructByIdQuery(id, TransactionFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, TransactionResponse{}, c)
-}
+		gqlClient := graphql.NewClient(url, endpointOpts...)
+		endpointLogger := logger
+		if len(urls) > 1 {
+			endpointLogger = logger.With(slog.String("endpoint", url))
+		}
+		gqlClient.Log = func(s string) {
+			endpointLogger.Debug("graphql client", "detail", s)
+		}
 
-func (c *Client) ListTransactions(ctx context.Context, opts *RequestOptions) (*ListTransactionsResponse, error) {
-	req, err := constructListQuery(TransactionFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListTransactionsResponse{}, c)
-}
+		// health checks have their own timeout, and a dead endpoint shouldn't hold them up with backoff or rate limiting
+		checkClient := graphql.NewClient(url, append(slices.Clip(gqlOpts), graphql.WithRetryPolicy(graphql.RetryPolicy{MaxAttempts: 1}))...)
+		checkClient.Log = gqlClient.Log
 
-func (c *Client) GetMintById(ctx context.Context, id string, opts *RequestOptions) (*MintResponse, error) {
-	req, err := constructByIdQuery(id, MintFields, opts)
-	if err != nil {
-		return nil, err
+		endpoints[i] = &endpoint{url: url, gql: gqlClient, check: checkClient, limiter: limiter, healthy: true}
 	}
-	return executeRequestAndConvert(ctx, req, MintResponse{}, c)
-}
 
-func (c *Client) ListMints(ctx context.Context, opts *RequestOptions) (*ListMintsResponse, error) {
-	req, err := constructListQuery(MintFields, opts)
-	if err != nil {
-		return nil, err
+	failover := FailoverOptions{}
+	if opts.Failover != nil {
+		failover = *opts.Failover
 	}
-	return executeRequestAndConvert(ctx, req, ListMintsResponse{}, c)
-}
 
-func (c *Client) GetBurnById(ctx context.Context, id string, opts *RequestOptions) (*BurnResponse, error) {
-	req, err := constructByIdQuery(id, BurnFields, opts)
-	if err != nil {
-		return nil, err
+	var cache *responseCache
+	if opts.Cache != nil {
+		cache = &responseCache{cache: opts.Cache}
+		if opts.CacheOptions != nil {
+			cache.opts = *opts.CacheOptions
+		}
 	}
-	return executeRequestAndConvert(ctx, req, BurnResponse{}, c)
-}
 
-func (c *Client) ListBurns(ctx context.Context, opts *RequestOptions) (*ListBurnsResponse, error) {
-	req, err := constructListQuery(BurnFields, opts)
-	if err != nil {
-		return nil, err
+	return &Client{
+		hostUrl:   urls[0],
+		GqlClient: endpoints[0].gql,
+		logger:    logger,
+		endpoints: endpoints,
+		health:    &healthState{opts: failover, policy: failoverPolicy},
+		chainHead: opts.ChainHead,
+		cache:     cache,
 	}
-	return executeRequestAndConvert(ctx, req, ListBurnsResponse{}, c)
 }
 
-func (c *Client) GetSwapById(ctx context.Context, id string, opts *RequestOptions) (*SwapResponse, error) {
-	req, err := constructByIdQuery(id, SwapFields, opts)
-	if err != nil {
+func executeRequestAndConvert[T Response](ctx context.Context, req *graphql.Request, converted T, c *Client, opts *RequestOptions) (*T, error) {
+	err := executeRequest(ctx, req, c, opts, func(resp interface{}) error {
+		return decodeResponse(resp, &converted)
+	})
+	if _, partial := err.(*PartialDataError); err != nil && !partial {
 		return nil, err
 	}
-	return executeRequestAndConvert(ctx, req, SwapResponse{}, c)
+	return &converted, err
 }
 
-func (c *Client) ListSwaps(ctx context.Context, opts *RequestOptions) (*ListSwapsResponse, error) {
-	req, err := constructListQuery(SwapFields, opts)
-	if err != nil {
-		return nil, err
+// runs a request, checks any staleness guard in opts and passes the raw response to decode. when opts.AllowPartialData
+// is set and the response has both data and errors, the data is decoded and a *PartialDataError is returned.
+func executeRequest(ctx context.Context, req *graphql.Request, c *Client, opts *RequestOptions, decode func(resp interface{}) error) error {
+	var resp interface{}
+	var partialErr *PartialDataError
+	if err := c.run(ctx, req, requestCachePolicy(opts), &resp); err != nil {
+		var respErr *graphql.ResponseError
+		if opts == nil || !opts.AllowPartialData || resp == nil || !errors.As(err, &respErr) {
+			return err
+		}
+		partialErr = &PartialDataError{ResponseError: respErr}
+	}
+
+	if hasStalenessGuard(opts) {
+		// the query selects _meta alongside the data (see assembleQuery), so the guard applies to the state the data was read at
+		var meta MetaResponse
+		if err := decodeResponse(resp, &meta); err != nil {
+			return err
+		}
+		if err := c.checkStaleness(ctx, meta.Meta, opts); err != nil {
+			return err
+		}
 	}
-	return executeRequestAndConvert(ctx, req, ListSwapsResponse{}, c)
-}
 
-func (c *Client) GetCollectById(ctx context.Context, id string, opts *RequestOptions) (*CollectResponse, error) {
-	req, err := constructByIdQuery(id, CollectFields, opts)
-	if err != nil {
-		return nil, err
+	if err := decode(resp); err != nil {
+		return err
 	}
-	return executeRequestAndConvert(ctx, req, CollectResponse{}, c)
-}
 
-func (c *Client) ListCollects(ctx context.Context, opts *RequestOptions) (*ListCollectsResponse, error) {
-	req, err := constructListQuery(CollectFields, opts)
-	if err != nil {
-		return nil, err
+	if partialErr != nil {
+		return partialErr
 	}
-	return executeRequestAndConvert(ctx, req, ListCollectsResponse{}, c)
+	return nil
 }
 
-func (c *Client) GetFlashById(ctx context.Context, id string, opts *RequestOptions) (*FlashResponse, error) {
-	req, err := constructByIdQuery(id, FlashFields, opts)
+// decodes a raw graphql response into result, converting scalar fields for the models in the typed package
+func decodeResponse(input interface{}, result interface{}) error {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: typed.DecodeHook,
+		Result:     result,
+	})
 	if err != nil {
-		return nil, err
+		return err
 	}
-	return executeRequestAndConvert(ctx, req, FlashResponse{}, c)
+	return decoder.Decode(input)
 }
 
-func (c *Client) ListFlashes(ctx context.Context, opts *RequestOptions) (*ListFlashesResponse, error) {
-	req, err := constructListQuery(FlashFields, opts)
-	if err != nil {
-		return nil, err
+// runs a raw graphql request, failing over between the client's endpoints, and logs the request and its outcome.
+// policy (see requestCachePolicy) decides whether and how long the response can be cached.
+func (c *Client) run(ctx context.Context, req *graphql.Request, policy cachePolicy, resp interface{}) error {
+	logger := c.logger
+	if logger == nil {
+		logger = discardLogger
 	}
-	return executeRequestAndConvert(ctx, req, ListFlashesResponse{}, c)
-}
+	logger = logger.With(
+		slog.String("request_id", newRequestId()),
+		slog.String("query", operationName(req.Query())),
+		slog.String("query_hash", req.Hash()),
+	)
 
-func (c *Client) GetUniswapDayDataById(ctx context.Context, id string, opts *RequestOptions) (*UniswapDayDataResponse, error) {
-	req, err := constructByIdQuery(id, UniswapDayDataFields, opts)
-	if err != nil {
-		return nil, err
+	if c.cache != nil && policy != noCache {
+		return c.runCached(ctx, req, resp, policy == cachePinned, logger)
 	}
-	return executeRequestAndConvert(ctx, req, UniswapDayDataResponse{}, c)
+	return c.runEndpoints(ctx, req, resp, logger)
 }
 
-func (c *Client) ListUniswapDayDatas(ctx context.Context, opts *RequestOptions) (*ListUniswapDayDatasResponse, error) {
-	req, err := constructListQuery(UniswapDayDataFields, opts)
-	if err != nil {
-		return nil, err
+// runs a raw graphql request through the client's cache
+func (c *Client) runCached(ctx context.Context, req *graphql.Request, resp interface{}, pinned bool, logger *slog.Logger) error {
+	key := c.cacheKey(req)
+	if data, ok := c.cacheGet(ctx, key); ok {
+		logger.Debug("subgraph request served from cache", slog.Bool("pinned", pinned))
+		if resp == nil {
+			return nil
+		}
+		return json.Unmarshal(data, resp)
 	}
-	return executeRequestAndConvert(ctx, req, ListUniswapDayDatasResponse{}, c)
-}
 
-func (c *Client) GetPoolDayDataById(ctx context.Context, id string, opts *RequestOptions) (*PoolDayDataResponse, error) {
-	req, err := constructByIdQuery(id, PoolDayDataFields, opts)
-	if err != nil {
-		return nil, err
+	var raw json.RawMessage
+	err := c.runEndpoints(ctx, req, &raw, logger)
+	if err == nil {
+		c.cacheSet(ctx, key, raw, pinned)
 	}
-	return executeRequestAndConvert(ctx, req, PoolDayDataResponse{}, c)
-}
-
-func (c *Client) ListPoolDayDatas(ctx context.Context, opts *RequestOptions) (*ListPoolDayDatasResponse, error) {
-	req, err := constructListQuery(PoolDayDataFields, opts)
-	if err != nil {
-		return nil, err
+	if resp == nil || len(raw) == 0 {
+		return err
 	}
-	return executeRequestAndConvert(ctx, req, ListPoolDayDatasResponse{}, c)
-}
-
-func (c *Client) GetPoolHourDataById(ctx context.Context, id string, opts *RequestOptions) (*PoolHourDataResponse, error) {
-	req, err := constructByIdQuery(id, PoolHourDataFields, opts)
-	if err != nil {
-		return nil, err
+	if unmarshalErr := json.Unmarshal(raw, resp); err == nil {
+		err = unmarshalErr
 	}
-	return executeRequestAndConvert(ctx, req, PoolHourDataResponse{}, c)
+	return err
 }
 
-func (c *Client) ListPoolHourDatas(ctx context.Context, opts *RequestOptions) (*ListPoolHourDatasResponse, error) {
-	req, err := constructListQuery(PoolHourDataFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListPoolHourDatasResponse{}, c)
-}
+// runs a raw graphql request, trying the client's endpoints in order until one succeeds. only transient errors (see
+// graphql.RetryPolicy.Retryable) fail over; query errors are returned immediately without affecting endpoint health.
+func (c *Client) runEndpoints(ctx context.Context, req *graphql.Request, resp interface{}, logger *slog.Logger) error {
+	endpoints := c.route()
+	var err error
+	for i, e := range endpoints {
+		endpointLogger := logger
+		if len(c.endpoints) > 1 {
+			endpointLogger = logger.With(slog.String("endpoint", e.url))
+		}
 
-func (c *Client) GetTickHourDataById(ctx context.Context, id string, opts *RequestOptions) (*TickHourDataResponse, error) {
-	req, err := constructByIdQuery(id, TickHourDataFields, opts)
-	if err != nil {
-		return nil, err
+		err = c.runOn(ctx, e, req, resp, endpointLogger)
+		if info, ok := ctx.Value(responseInfoKey{}).(*ResponseInfo); ok {
+			info.Endpoint = e.url
+			info.Attempts = i + 1
+		}
+		if err == nil || ctx.Err() != nil || !c.health.policy.Retryable(err) {
+			return err
+		}
+		if i < len(endpoints)-1 {
+			c.markUnhealthy(e, err)
+			endpointLogger.Warn("subgraph request failing over", slog.String("next_endpoint", endpoints[i+1].url))
+		}
 	}
-	return executeRequestAndConvert(ctx, req, TickHourDataResponse{}, c)
+	return err
 }
 
-func (c *Client) ListTickHourDatas(ctx context.Context, opts *RequestOptions) (*ListTickHourDatasResponse, error) {
-	req, err := constructListQuery(TickHourDataFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, ListTickHourDatasResponse{}, c)
-}
+// runs a raw graphql request against a single endpoint
+func (c *Client) runOn(ctx context.Context, e *endpoint, req *graphql.Request, resp interface{}, logger *slog.Logger) error {
+	// the endpoint's rate limiter (run before each attempt by the graphql client) logs with the request's logger
+	ctx = context.WithValue(ctx, requestLoggerKey{}, logger)
 
-func (c *Client) GetTickDayDataById(ctx context.Context, id string, opts *RequestOptions) (*TickDayDataResponse, error) {
-	req, err := constructByIdQuery(id, TickDayDataFields, opts)
-	if err != nil {
-		return nil, err
-	}
-	return executeRequestAndConvert(ctx, req, TickDayDataResponse{}, c)
-}
+	logger.Debug("subgraph request started", slog.Any("variables", req.Vars()))
 
-func (c *Client) ListTickDayDatas(ctx context.Context, opts *RequestOptions) (*ListTickDayDatasResponse, error) {
-	req, err := constructListQuery(TickDayDataFields, opts)
+	start := time.Now()
+	var raw json.RawMessage
+	err := e.gql.Run(ctx, req, &raw)
+	latency := time.Since(start)
 	if err != nil {
-		return nil, err
+		logger.Error("subgraph request failed", slog.Duration("latency", latency), slog.Any("error", err))
+		if resp != nil && len(raw) > 0 {
+			// keep any data returned with the errors, for RequestOptions.AllowPartialData
+			json.Unmarshal(raw, resp)
+		}
+		return err
 	}
-	return executeRequestAndConvert(ctx, req, ListTickDayDatasResponse{}, c)
-}
+	logger.Info("subgraph request completed", slog.Duration("latency", latency), slog.Int("response_bytes", len(raw)))
 
-func (c *Client) GetTokenDayDataById(ctx context.Context, id string, opts *RequestOptions) (*TokenDayDataResponse, error) {
-	req, err := constructByIdQuery(id, TokenDayDataFields, opts)
-	if err != nil {
-		return nil, err
+	if resp == nil || len(raw) == 0 {
+		return nil
 	}
-	return executeRequestAndConvert(ctx, req, TokenDayDataResponse{}, c)
+	return json.Unmarshal(raw, resp)
 }
 
-func (c *Client) ListTokenDayDatas(ctx context.Context, opts *RequestOptions) (*ListTokenDayDatasResponse, error) {
-	req, err := constructListQuery(TokenDayDataFields, opts)
-	if err != nil {
-		return nil, err
+// returns the operation name of a query e.g. `query pools($first: Int!) {...}` -> `pools`
+func operationName(query string) string {
+	name, found := strings.CutPrefix(strings.TrimSpace(query), "query ")
+	if !found {
+		return ""
 	}
-	return executeRequestAndConvert(ctx, req, ListTokenDayDatasResponse{}, c)
-}
-
-func (c *Client) GetTokenHourDataById(ctx context.Context, id string, opts *RequestOptions) (*TokenHourDataResponse, error) {
-	req, err := constructByIdQuery(id, TokenHourDataFields, opts)
-	if err != nil {
-		return nil, err
+	if i := strings.IndexAny(name, "({ \n"); i >= 0 {
+		name = name[:i]
 	}
-	return executeRequestAndConvert(ctx, req, TokenHourDataResponse{}, c)
+	return name
 }
 
-func (c *Client) ListTokenHourDatas(ctx context.Context, opts *RequestOptions) (*ListTokenHourDatasResponse, error) {
-	req, err := constructListQuery(TokenHourDataFields, opts)
-	if err != nil {
-		return nil, err
+func newRequestId() string {
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return ""
 	}
-	return executeRequestAndConvert(ctx, req, ListTokenHourDatasResponse{}, c)
+	return hex.EncodeToString(b)
 }
 
-func executeRequestAndConvert[T Response](ctx context.Context, req *graphql.Request, converted T, c *Client) (*T, error) {
-	var resp interface{}
-	if err := c.GqlClient.Run(ctx, req, &resp); err != nil {
-		return nil, err
-	}
+// logger used when ClientOptions.Logger is nil
+var discardLogger *slog.Logger = slog.New(discardHandler{})
 
-	if err := mapstructure.Decode(resp, &converted); err != nil {
-		return nil, err
-	}
+type discardHandler struct{}
 
-	return &converted, nil
-}
+func (discardHandler) Enabled(context.Context, slog.Level) bool  { return false }
+func (discardHandler) Handle(context.Context, slog.Record) error { return nil }
+func (h discardHandler) WithAttrs([]slog.Attr) slog.Handler      { return h }
+func (h discardHandler) WithGroup(string) slog.Handler           { return h }
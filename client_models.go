@@ -0,0 +1,628 @@
+// Code generated by modelgen from schema.graphql. DO NOT EDIT.
+
+package unigraphclient
+
+import (
+	"context"
+	"iter"
+)
+
+func (c *Client) GetFactoryById(ctx context.Context, id string, opts *RequestOptions) (*FactoryResponse, error) {
+	req, err := constructByIdQuery(id, FactoryFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, FactoryResponse{}, c, opts)
+}
+
+func (c *Client) ListFactories(ctx context.Context, opts *RequestOptions) (*ListFactoriesResponse, error) {
+	req, err := constructListQuery(FactoryFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListFactoriesResponse{}, c, opts)
+}
+
+// IterFactories streams every factory matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterFactories(ctx context.Context, opts *RequestOptions) iter.Seq2[Factory, error] {
+	return iterate[Factory](ctx, c, FactoryFields, opts)
+}
+
+// ListAllFactories collects every factory matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllFactories(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListFactoriesResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, FactoryFields, opts, limits, ListFactoriesResponse{})
+}
+
+// ListFactoriesByIds fetches factories by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListFactoriesByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Factory], error) {
+	return listByIds[Factory](ctx, c, FactoryFields, ids, opts)
+}
+
+func (c *Client) GetPoolById(ctx context.Context, id string, opts *RequestOptions) (*PoolResponse, error) {
+	req, err := constructByIdQuery(id, PoolFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, PoolResponse{}, c, opts)
+}
+
+func (c *Client) ListPools(ctx context.Context, opts *RequestOptions) (*ListPoolsResponse, error) {
+	req, err := constructListQuery(PoolFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListPoolsResponse{}, c, opts)
+}
+
+// IterPools streams every pool matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterPools(ctx context.Context, opts *RequestOptions) iter.Seq2[Pool, error] {
+	return iterate[Pool](ctx, c, PoolFields, opts)
+}
+
+// ListAllPools collects every pool matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllPools(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListPoolsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, PoolFields, opts, limits, ListPoolsResponse{})
+}
+
+// ListPoolsByIds fetches pools by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListPoolsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Pool], error) {
+	return listByIds[Pool](ctx, c, PoolFields, ids, opts)
+}
+
+func (c *Client) GetTokenById(ctx context.Context, id string, opts *RequestOptions) (*TokenResponse, error) {
+	req, err := constructByIdQuery(id, TokenFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TokenResponse{}, c, opts)
+}
+
+func (c *Client) ListTokens(ctx context.Context, opts *RequestOptions) (*ListTokensResponse, error) {
+	req, err := constructListQuery(TokenFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTokensResponse{}, c, opts)
+}
+
+// IterTokens streams every token matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTokens(ctx context.Context, opts *RequestOptions) iter.Seq2[Token, error] {
+	return iterate[Token](ctx, c, TokenFields, opts)
+}
+
+// ListAllTokens collects every token matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTokens(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTokensResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TokenFields, opts, limits, ListTokensResponse{})
+}
+
+// ListTokensByIds fetches tokens by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTokensByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Token], error) {
+	return listByIds[Token](ctx, c, TokenFields, ids, opts)
+}
+
+func (c *Client) GetBundleById(ctx context.Context, id string, opts *RequestOptions) (*BundleResponse, error) {
+	req, err := constructByIdQuery(id, BundleFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, BundleResponse{}, c, opts)
+}
+
+func (c *Client) ListBundles(ctx context.Context, opts *RequestOptions) (*ListBundlesResponse, error) {
+	req, err := constructListQuery(BundleFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListBundlesResponse{}, c, opts)
+}
+
+// IterBundles streams every bundle matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterBundles(ctx context.Context, opts *RequestOptions) iter.Seq2[Bundle, error] {
+	return iterate[Bundle](ctx, c, BundleFields, opts)
+}
+
+// ListAllBundles collects every bundle matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllBundles(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListBundlesResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, BundleFields, opts, limits, ListBundlesResponse{})
+}
+
+// ListBundlesByIds fetches bundles by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListBundlesByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Bundle], error) {
+	return listByIds[Bundle](ctx, c, BundleFields, ids, opts)
+}
+
+func (c *Client) GetTickById(ctx context.Context, id string, opts *RequestOptions) (*TickResponse, error) {
+	req, err := constructByIdQuery(id, TickFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TickResponse{}, c, opts)
+}
+
+func (c *Client) ListTicks(ctx context.Context, opts *RequestOptions) (*ListTicksResponse, error) {
+	req, err := constructListQuery(TickFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTicksResponse{}, c, opts)
+}
+
+// IterTicks streams every tick matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTicks(ctx context.Context, opts *RequestOptions) iter.Seq2[Tick, error] {
+	return iterate[Tick](ctx, c, TickFields, opts)
+}
+
+// ListAllTicks collects every tick matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTicks(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTicksResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TickFields, opts, limits, ListTicksResponse{})
+}
+
+// ListTicksByIds fetches ticks by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTicksByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Tick], error) {
+	return listByIds[Tick](ctx, c, TickFields, ids, opts)
+}
+
+func (c *Client) GetPositionById(ctx context.Context, id string, opts *RequestOptions) (*PositionResponse, error) {
+	req, err := constructByIdQuery(id, PositionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, PositionResponse{}, c, opts)
+}
+
+func (c *Client) ListPositions(ctx context.Context, opts *RequestOptions) (*ListPositionsResponse, error) {
+	req, err := constructListQuery(PositionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListPositionsResponse{}, c, opts)
+}
+
+// IterPositions streams every position matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterPositions(ctx context.Context, opts *RequestOptions) iter.Seq2[Position, error] {
+	return iterate[Position](ctx, c, PositionFields, opts)
+}
+
+// ListAllPositions collects every position matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllPositions(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListPositionsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, PositionFields, opts, limits, ListPositionsResponse{})
+}
+
+// ListPositionsByIds fetches positions by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListPositionsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Position], error) {
+	return listByIds[Position](ctx, c, PositionFields, ids, opts)
+}
+
+func (c *Client) GetPositionSnapshotById(ctx context.Context, id string, opts *RequestOptions) (*PositionSnapshotResponse, error) {
+	req, err := constructByIdQuery(id, PositionSnapshotFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, PositionSnapshotResponse{}, c, opts)
+}
+
+func (c *Client) ListPositionSnapshots(ctx context.Context, opts *RequestOptions) (*ListPositionSnapshotsResponse, error) {
+	req, err := constructListQuery(PositionSnapshotFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListPositionSnapshotsResponse{}, c, opts)
+}
+
+// IterPositionSnapshots streams every positionSnapshot matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterPositionSnapshots(ctx context.Context, opts *RequestOptions) iter.Seq2[PositionSnapshot, error] {
+	return iterate[PositionSnapshot](ctx, c, PositionSnapshotFields, opts)
+}
+
+// ListAllPositionSnapshots collects every positionSnapshot matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllPositionSnapshots(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListPositionSnapshotsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, PositionSnapshotFields, opts, limits, ListPositionSnapshotsResponse{})
+}
+
+// ListPositionSnapshotsByIds fetches positionSnapshots by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListPositionSnapshotsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[PositionSnapshot], error) {
+	return listByIds[PositionSnapshot](ctx, c, PositionSnapshotFields, ids, opts)
+}
+
+func (c *Client) GetTransactionById(ctx context.Context, id string, opts *RequestOptions) (*TransactionResponse, error) {
+	req, err := constructByIdQuery(id, TransactionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TransactionResponse{}, c, opts)
+}
+
+func (c *Client) ListTransactions(ctx context.Context, opts *RequestOptions) (*ListTransactionsResponse, error) {
+	req, err := constructListQuery(TransactionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTransactionsResponse{}, c, opts)
+}
+
+// IterTransactions streams every transaction matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTransactions(ctx context.Context, opts *RequestOptions) iter.Seq2[Transaction, error] {
+	return iterate[Transaction](ctx, c, TransactionFields, opts)
+}
+
+// ListAllTransactions collects every transaction matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTransactions(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTransactionsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TransactionFields, opts, limits, ListTransactionsResponse{})
+}
+
+// ListTransactionsByIds fetches transactions by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTransactionsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Transaction], error) {
+	return listByIds[Transaction](ctx, c, TransactionFields, ids, opts)
+}
+
+func (c *Client) GetMintById(ctx context.Context, id string, opts *RequestOptions) (*MintResponse, error) {
+	req, err := constructByIdQuery(id, MintFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, MintResponse{}, c, opts)
+}
+
+func (c *Client) ListMints(ctx context.Context, opts *RequestOptions) (*ListMintsResponse, error) {
+	req, err := constructListQuery(MintFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListMintsResponse{}, c, opts)
+}
+
+// IterMints streams every mint matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterMints(ctx context.Context, opts *RequestOptions) iter.Seq2[Mint, error] {
+	return iterate[Mint](ctx, c, MintFields, opts)
+}
+
+// ListAllMints collects every mint matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllMints(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListMintsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, MintFields, opts, limits, ListMintsResponse{})
+}
+
+// ListMintsByIds fetches mints by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListMintsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Mint], error) {
+	return listByIds[Mint](ctx, c, MintFields, ids, opts)
+}
+
+func (c *Client) GetBurnById(ctx context.Context, id string, opts *RequestOptions) (*BurnResponse, error) {
+	req, err := constructByIdQuery(id, BurnFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, BurnResponse{}, c, opts)
+}
+
+func (c *Client) ListBurns(ctx context.Context, opts *RequestOptions) (*ListBurnsResponse, error) {
+	req, err := constructListQuery(BurnFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListBurnsResponse{}, c, opts)
+}
+
+// IterBurns streams every burn matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterBurns(ctx context.Context, opts *RequestOptions) iter.Seq2[Burn, error] {
+	return iterate[Burn](ctx, c, BurnFields, opts)
+}
+
+// ListAllBurns collects every burn matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllBurns(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListBurnsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, BurnFields, opts, limits, ListBurnsResponse{})
+}
+
+// ListBurnsByIds fetches burns by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListBurnsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Burn], error) {
+	return listByIds[Burn](ctx, c, BurnFields, ids, opts)
+}
+
+func (c *Client) GetSwapById(ctx context.Context, id string, opts *RequestOptions) (*SwapResponse, error) {
+	req, err := constructByIdQuery(id, SwapFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, SwapResponse{}, c, opts)
+}
+
+func (c *Client) ListSwaps(ctx context.Context, opts *RequestOptions) (*ListSwapsResponse, error) {
+	req, err := constructListQuery(SwapFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListSwapsResponse{}, c, opts)
+}
+
+// IterSwaps streams every swap matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterSwaps(ctx context.Context, opts *RequestOptions) iter.Seq2[Swap, error] {
+	return iterate[Swap](ctx, c, SwapFields, opts)
+}
+
+// ListAllSwaps collects every swap matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllSwaps(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListSwapsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, SwapFields, opts, limits, ListSwapsResponse{})
+}
+
+// ListSwapsByIds fetches swaps by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListSwapsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Swap], error) {
+	return listByIds[Swap](ctx, c, SwapFields, ids, opts)
+}
+
+func (c *Client) GetCollectById(ctx context.Context, id string, opts *RequestOptions) (*CollectResponse, error) {
+	req, err := constructByIdQuery(id, CollectFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, CollectResponse{}, c, opts)
+}
+
+func (c *Client) ListCollects(ctx context.Context, opts *RequestOptions) (*ListCollectsResponse, error) {
+	req, err := constructListQuery(CollectFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListCollectsResponse{}, c, opts)
+}
+
+// IterCollects streams every collect matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterCollects(ctx context.Context, opts *RequestOptions) iter.Seq2[Collect, error] {
+	return iterate[Collect](ctx, c, CollectFields, opts)
+}
+
+// ListAllCollects collects every collect matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllCollects(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListCollectsResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, CollectFields, opts, limits, ListCollectsResponse{})
+}
+
+// ListCollectsByIds fetches collects by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListCollectsByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Collect], error) {
+	return listByIds[Collect](ctx, c, CollectFields, ids, opts)
+}
+
+func (c *Client) GetFlashById(ctx context.Context, id string, opts *RequestOptions) (*FlashResponse, error) {
+	req, err := constructByIdQuery(id, FlashFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, FlashResponse{}, c, opts)
+}
+
+func (c *Client) ListFlashes(ctx context.Context, opts *RequestOptions) (*ListFlashesResponse, error) {
+	req, err := constructListQuery(FlashFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListFlashesResponse{}, c, opts)
+}
+
+// IterFlashes streams every flash matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterFlashes(ctx context.Context, opts *RequestOptions) iter.Seq2[Flash, error] {
+	return iterate[Flash](ctx, c, FlashFields, opts)
+}
+
+// ListAllFlashes collects every flash matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllFlashes(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListFlashesResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, FlashFields, opts, limits, ListFlashesResponse{})
+}
+
+// ListFlashesByIds fetches flashes by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListFlashesByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[Flash], error) {
+	return listByIds[Flash](ctx, c, FlashFields, ids, opts)
+}
+
+func (c *Client) GetUniswapDayDataById(ctx context.Context, id string, opts *RequestOptions) (*UniswapDayDataResponse, error) {
+	req, err := constructByIdQuery(id, UniswapDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, UniswapDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListUniswapDayDatas(ctx context.Context, opts *RequestOptions) (*ListUniswapDayDatasResponse, error) {
+	req, err := constructListQuery(UniswapDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListUniswapDayDatasResponse{}, c, opts)
+}
+
+// IterUniswapDayDatas streams every uniswapDayData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterUniswapDayDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[UniswapDayData, error] {
+	return iterate[UniswapDayData](ctx, c, UniswapDayDataFields, opts)
+}
+
+// ListAllUniswapDayDatas collects every uniswapDayData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllUniswapDayDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListUniswapDayDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, UniswapDayDataFields, opts, limits, ListUniswapDayDatasResponse{})
+}
+
+// ListUniswapDayDatasByIds fetches uniswapDayDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListUniswapDayDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[UniswapDayData], error) {
+	return listByIds[UniswapDayData](ctx, c, UniswapDayDataFields, ids, opts)
+}
+
+func (c *Client) GetPoolDayDataById(ctx context.Context, id string, opts *RequestOptions) (*PoolDayDataResponse, error) {
+	req, err := constructByIdQuery(id, PoolDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, PoolDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListPoolDayDatas(ctx context.Context, opts *RequestOptions) (*ListPoolDayDatasResponse, error) {
+	req, err := constructListQuery(PoolDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListPoolDayDatasResponse{}, c, opts)
+}
+
+// IterPoolDayDatas streams every poolDayData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterPoolDayDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[PoolDayData, error] {
+	return iterate[PoolDayData](ctx, c, PoolDayDataFields, opts)
+}
+
+// ListAllPoolDayDatas collects every poolDayData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllPoolDayDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListPoolDayDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, PoolDayDataFields, opts, limits, ListPoolDayDatasResponse{})
+}
+
+// ListPoolDayDatasByIds fetches poolDayDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListPoolDayDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[PoolDayData], error) {
+	return listByIds[PoolDayData](ctx, c, PoolDayDataFields, ids, opts)
+}
+
+func (c *Client) GetPoolHourDataById(ctx context.Context, id string, opts *RequestOptions) (*PoolHourDataResponse, error) {
+	req, err := constructByIdQuery(id, PoolHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, PoolHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListPoolHourDatas(ctx context.Context, opts *RequestOptions) (*ListPoolHourDatasResponse, error) {
+	req, err := constructListQuery(PoolHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListPoolHourDatasResponse{}, c, opts)
+}
+
+// IterPoolHourDatas streams every poolHourData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterPoolHourDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[PoolHourData, error] {
+	return iterate[PoolHourData](ctx, c, PoolHourDataFields, opts)
+}
+
+// ListAllPoolHourDatas collects every poolHourData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllPoolHourDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListPoolHourDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, PoolHourDataFields, opts, limits, ListPoolHourDatasResponse{})
+}
+
+// ListPoolHourDatasByIds fetches poolHourDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListPoolHourDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[PoolHourData], error) {
+	return listByIds[PoolHourData](ctx, c, PoolHourDataFields, ids, opts)
+}
+
+func (c *Client) GetTickHourDataById(ctx context.Context, id string, opts *RequestOptions) (*TickHourDataResponse, error) {
+	req, err := constructByIdQuery(id, TickHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TickHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTickHourDatas(ctx context.Context, opts *RequestOptions) (*ListTickHourDatasResponse, error) {
+	req, err := constructListQuery(TickHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTickHourDatasResponse{}, c, opts)
+}
+
+// IterTickHourDatas streams every tickHourData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTickHourDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[TickHourData, error] {
+	return iterate[TickHourData](ctx, c, TickHourDataFields, opts)
+}
+
+// ListAllTickHourDatas collects every tickHourData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTickHourDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTickHourDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TickHourDataFields, opts, limits, ListTickHourDatasResponse{})
+}
+
+// ListTickHourDatasByIds fetches tickHourDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTickHourDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[TickHourData], error) {
+	return listByIds[TickHourData](ctx, c, TickHourDataFields, ids, opts)
+}
+
+func (c *Client) GetTickDayDataById(ctx context.Context, id string, opts *RequestOptions) (*TickDayDataResponse, error) {
+	req, err := constructByIdQuery(id, TickDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TickDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTickDayDatas(ctx context.Context, opts *RequestOptions) (*ListTickDayDatasResponse, error) {
+	req, err := constructListQuery(TickDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTickDayDatasResponse{}, c, opts)
+}
+
+// IterTickDayDatas streams every tickDayData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTickDayDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[TickDayData, error] {
+	return iterate[TickDayData](ctx, c, TickDayDataFields, opts)
+}
+
+// ListAllTickDayDatas collects every tickDayData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTickDayDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTickDayDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TickDayDataFields, opts, limits, ListTickDayDatasResponse{})
+}
+
+// ListTickDayDatasByIds fetches tickDayDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTickDayDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[TickDayData], error) {
+	return listByIds[TickDayData](ctx, c, TickDayDataFields, ids, opts)
+}
+
+func (c *Client) GetTokenDayDataById(ctx context.Context, id string, opts *RequestOptions) (*TokenDayDataResponse, error) {
+	req, err := constructByIdQuery(id, TokenDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TokenDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTokenDayDatas(ctx context.Context, opts *RequestOptions) (*ListTokenDayDatasResponse, error) {
+	req, err := constructListQuery(TokenDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTokenDayDatasResponse{}, c, opts)
+}
+
+// IterTokenDayDatas streams every tokenDayData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTokenDayDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[TokenDayData, error] {
+	return iterate[TokenDayData](ctx, c, TokenDayDataFields, opts)
+}
+
+// ListAllTokenDayDatas collects every tokenDayData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTokenDayDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTokenDayDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TokenDayDataFields, opts, limits, ListTokenDayDatasResponse{})
+}
+
+// ListTokenDayDatasByIds fetches tokenDayDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTokenDayDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[TokenDayData], error) {
+	return listByIds[TokenDayData](ctx, c, TokenDayDataFields, ids, opts)
+}
+
+func (c *Client) GetTokenHourDataById(ctx context.Context, id string, opts *RequestOptions) (*TokenHourDataResponse, error) {
+	req, err := constructByIdQuery(id, TokenHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, TokenHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTokenHourDatas(ctx context.Context, opts *RequestOptions) (*ListTokenHourDatasResponse, error) {
+	req, err := constructListQuery(TokenHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, ListTokenHourDatasResponse{}, c, opts)
+}
+
+// IterTokenHourDatas streams every tokenHourData matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) IterTokenHourDatas(ctx context.Context, opts *RequestOptions) iter.Seq2[TokenHourData, error] {
+	return iterate[TokenHourData](ctx, c, TokenHourDataFields, opts)
+}
+
+// ListAllTokenHourDatas collects every tokenHourData matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAllTokenHourDatas(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*ListTokenHourDatasResponse, *PageSummary, error) {
+	return fetchAll(ctx, c, TokenHourDataFields, opts, limits, ListTokenHourDatasResponse{})
+}
+
+// ListTokenHourDatasByIds fetches tokenHourDatas by id with `id_in` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) ListTokenHourDatasByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[TokenHourData], error) {
+	return listByIds[TokenHourData](ctx, c, TokenHourDataFields, ids, opts)
+}
@@ -1,13 +1,18 @@
 package unigraphclient
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
+	"log/slog"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -28,6 +33,28 @@ func TestNewClient(t *testing.T) {
 
 		assert.NotNil(t, client)
 	})
+
+	t.Run("when a retry policy is given", func(t *testing.T) {
+		var calls int
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			io.WriteString(w, `{"data": {"factory": {"id": "test"}}}`)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{
+			RetryPolicy: &graphql.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		})
+
+		resp, err := client.GetFactoryById(context.Background(), "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", resp.Factory.ID)
+		assert.Equal(t, 2, calls)
+	})
 }
 
 func TestGetFactoryById(t *testing.T) {
@@ -198,6 +225,41 @@ func TestListTokens(t *testing.T) {
 	})
 }
 
+func TestGetPoolById_DerivedFields(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Contains(t, string(body), `"swaps_where":{"amountUSD_gt":"1000"}`)
+			io.WriteString(w, `{
+				"data": {
+					"pool": {
+						"id": "test",
+						"swaps": [
+							{"id": "swap1", "amountUSD": "1500"},
+							{"id": "swap2", "amountUSD": "2500"}
+						]
+					}
+				}
+			}`)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		opts := &RequestOptions{
+			IncludeFields: []string{"id", "swaps.id", "swaps.amountUSD"},
+			Derived: map[string]DerivedOptions{
+				"swaps": {First: 2, Where: Where{"amountUSD_gt": "1000"}},
+			},
+		}
+		resp, err := client.GetPoolById(context.Background(), "test", opts)
+		assert.Nil(t, err)
+		assert.Len(t, resp.Pool.Swaps, 2)
+		assert.Equal(t, "2500", resp.Pool.Swaps[1].AmountUSD)
+	})
+}
+
 func TestExecuteRequestAndConvert(t *testing.T) {
 	t.Run("when successful", func(t *testing.T) {
 		server := getTestServer(t, SuccessList, "factory")
@@ -209,7 +271,7 @@ func TestExecuteRequestAndConvert(t *testing.T) {
 		req, err := constructListQuery(FactoryFields, nil)
 		assert.Nil(t, err)
 
-		resp, err := executeRequestAndConvert(context.Background(), req, ListFactoriesResponse{}, client)
+		resp, err := executeRequestAndConvert(context.Background(), req, ListFactoriesResponse{}, client, nil)
 		assert.Nil(t, err)
 		assert.Len(t, resp.Factories, 1)
 		assert.Equal(t, id, resp.Factories[0].ID)
@@ -224,12 +286,71 @@ func TestExecuteRequestAndConvert(t *testing.T) {
 		req, err := constructListQuery(FactoryFields, nil)
 		assert.Nil(t, err)
 
-		_, err = executeRequestAndConvert(context.Background(), req, ListFactoriesResponse{}, client)
+		_, err = executeRequestAndConvert(context.Background(), req, ListFactoriesResponse{}, client, nil)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "server returned a non-200 status code")
 	})
 }
 
+func TestClientLogging(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTestServer(t, SuccessById, "factory")
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		client := NewClient(server.URL, &ClientOptions{Logger: logger})
+
+		_, err := client.GetFactoryById(context.Background(), "test", nil)
+		assert.Nil(t, err)
+
+		var record map[string]any
+		assert.Nil(t, json.Unmarshal(buf.Bytes(), &record))
+		assert.Equal(t, "INFO", record["level"])
+		assert.Equal(t, "subgraph request completed", record["msg"])
+		assert.Equal(t, "factory", record["query"])
+		assert.Len(t, record["request_id"], 16)
+		assert.Len(t, record["query_hash"], 64)
+		assert.NotNil(t, record["latency"])
+		assert.Greater(t, record["response_bytes"], float64(0))
+	})
+
+	t.Run("when debug is enabled", func(t *testing.T) {
+		server := getTestServer(t, SuccessList, "factory")
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+		client := NewClient(server.URL, &ClientOptions{Logger: logger})
+
+		_, err := client.ListFactories(context.Background(), &RequestOptions{First: 5})
+		assert.Nil(t, err)
+		assert.Contains(t, buf.String(), `"msg":"subgraph request started"`)
+		assert.Contains(t, buf.String(), `"first":5`)
+		assert.Contains(t, buf.String(), `"query":"factories"`)
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "factory")
+		defer server.Close()
+
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, nil))
+		client := NewClient(server.URL, &ClientOptions{Logger: logger})
+
+		_, err := client.GetFactoryById(context.Background(), "test", nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, buf.String(), `"level":"ERROR"`)
+		assert.Contains(t, buf.String(), "non-200 status code")
+	})
+
+	t.Run("when no logger is given", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		assert.False(t, client.logger.Enabled(context.Background(), slog.LevelError))
+	})
+}
+
 type Case int
 
 const (
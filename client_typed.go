@@ -0,0 +1,331 @@
+// Code generated by modelgen from schema.graphql. DO NOT EDIT.
+
+package unigraphclient
+
+import (
+	"context"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/typed"
+)
+
+// typed variants of the Get*/List* methods, returning the models from the typed package
+
+func (c *Client) GetTypedFactoryById(ctx context.Context, id string, opts *RequestOptions) (*typed.FactoryResponse, error) {
+	req, err := constructByIdQuery(id, FactoryFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.FactoryResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedFactories(ctx context.Context, opts *RequestOptions) (*typed.ListFactoriesResponse, error) {
+	req, err := constructListQuery(FactoryFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListFactoriesResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedPoolById(ctx context.Context, id string, opts *RequestOptions) (*typed.PoolResponse, error) {
+	req, err := constructByIdQuery(id, PoolFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.PoolResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedPools(ctx context.Context, opts *RequestOptions) (*typed.ListPoolsResponse, error) {
+	req, err := constructListQuery(PoolFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListPoolsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTokenById(ctx context.Context, id string, opts *RequestOptions) (*typed.TokenResponse, error) {
+	req, err := constructByIdQuery(id, TokenFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TokenResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTokens(ctx context.Context, opts *RequestOptions) (*typed.ListTokensResponse, error) {
+	req, err := constructListQuery(TokenFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTokensResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedBundleById(ctx context.Context, id string, opts *RequestOptions) (*typed.BundleResponse, error) {
+	req, err := constructByIdQuery(id, BundleFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.BundleResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedBundles(ctx context.Context, opts *RequestOptions) (*typed.ListBundlesResponse, error) {
+	req, err := constructListQuery(BundleFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListBundlesResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTickById(ctx context.Context, id string, opts *RequestOptions) (*typed.TickResponse, error) {
+	req, err := constructByIdQuery(id, TickFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TickResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTicks(ctx context.Context, opts *RequestOptions) (*typed.ListTicksResponse, error) {
+	req, err := constructListQuery(TickFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTicksResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedPositionById(ctx context.Context, id string, opts *RequestOptions) (*typed.PositionResponse, error) {
+	req, err := constructByIdQuery(id, PositionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.PositionResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedPositions(ctx context.Context, opts *RequestOptions) (*typed.ListPositionsResponse, error) {
+	req, err := constructListQuery(PositionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListPositionsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedPositionSnapshotById(ctx context.Context, id string, opts *RequestOptions) (*typed.PositionSnapshotResponse, error) {
+	req, err := constructByIdQuery(id, PositionSnapshotFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.PositionSnapshotResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedPositionSnapshots(ctx context.Context, opts *RequestOptions) (*typed.ListPositionSnapshotsResponse, error) {
+	req, err := constructListQuery(PositionSnapshotFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListPositionSnapshotsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTransactionById(ctx context.Context, id string, opts *RequestOptions) (*typed.TransactionResponse, error) {
+	req, err := constructByIdQuery(id, TransactionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TransactionResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTransactions(ctx context.Context, opts *RequestOptions) (*typed.ListTransactionsResponse, error) {
+	req, err := constructListQuery(TransactionFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTransactionsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedMintById(ctx context.Context, id string, opts *RequestOptions) (*typed.MintResponse, error) {
+	req, err := constructByIdQuery(id, MintFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.MintResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedMints(ctx context.Context, opts *RequestOptions) (*typed.ListMintsResponse, error) {
+	req, err := constructListQuery(MintFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListMintsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedBurnById(ctx context.Context, id string, opts *RequestOptions) (*typed.BurnResponse, error) {
+	req, err := constructByIdQuery(id, BurnFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.BurnResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedBurns(ctx context.Context, opts *RequestOptions) (*typed.ListBurnsResponse, error) {
+	req, err := constructListQuery(BurnFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListBurnsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedSwapById(ctx context.Context, id string, opts *RequestOptions) (*typed.SwapResponse, error) {
+	req, err := constructByIdQuery(id, SwapFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.SwapResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedSwaps(ctx context.Context, opts *RequestOptions) (*typed.ListSwapsResponse, error) {
+	req, err := constructListQuery(SwapFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListSwapsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedCollectById(ctx context.Context, id string, opts *RequestOptions) (*typed.CollectResponse, error) {
+	req, err := constructByIdQuery(id, CollectFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.CollectResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedCollects(ctx context.Context, opts *RequestOptions) (*typed.ListCollectsResponse, error) {
+	req, err := constructListQuery(CollectFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListCollectsResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedFlashById(ctx context.Context, id string, opts *RequestOptions) (*typed.FlashResponse, error) {
+	req, err := constructByIdQuery(id, FlashFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.FlashResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedFlashes(ctx context.Context, opts *RequestOptions) (*typed.ListFlashesResponse, error) {
+	req, err := constructListQuery(FlashFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListFlashesResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedUniswapDayDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.UniswapDayDataResponse, error) {
+	req, err := constructByIdQuery(id, UniswapDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.UniswapDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedUniswapDayDatas(ctx context.Context, opts *RequestOptions) (*typed.ListUniswapDayDatasResponse, error) {
+	req, err := constructListQuery(UniswapDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListUniswapDayDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedPoolDayDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.PoolDayDataResponse, error) {
+	req, err := constructByIdQuery(id, PoolDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.PoolDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedPoolDayDatas(ctx context.Context, opts *RequestOptions) (*typed.ListPoolDayDatasResponse, error) {
+	req, err := constructListQuery(PoolDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListPoolDayDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedPoolHourDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.PoolHourDataResponse, error) {
+	req, err := constructByIdQuery(id, PoolHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.PoolHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedPoolHourDatas(ctx context.Context, opts *RequestOptions) (*typed.ListPoolHourDatasResponse, error) {
+	req, err := constructListQuery(PoolHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListPoolHourDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTickHourDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.TickHourDataResponse, error) {
+	req, err := constructByIdQuery(id, TickHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TickHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTickHourDatas(ctx context.Context, opts *RequestOptions) (*typed.ListTickHourDatasResponse, error) {
+	req, err := constructListQuery(TickHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTickHourDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTickDayDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.TickDayDataResponse, error) {
+	req, err := constructByIdQuery(id, TickDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TickDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTickDayDatas(ctx context.Context, opts *RequestOptions) (*typed.ListTickDayDatasResponse, error) {
+	req, err := constructListQuery(TickDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTickDayDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTokenDayDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.TokenDayDataResponse, error) {
+	req, err := constructByIdQuery(id, TokenDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TokenDayDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTokenDayDatas(ctx context.Context, opts *RequestOptions) (*typed.ListTokenDayDatasResponse, error) {
+	req, err := constructListQuery(TokenDayDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTokenDayDatasResponse{}, c, opts)
+}
+
+func (c *Client) GetTypedTokenHourDataById(ctx context.Context, id string, opts *RequestOptions) (*typed.TokenHourDataResponse, error) {
+	req, err := constructByIdQuery(id, TokenHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.TokenHourDataResponse{}, c, opts)
+}
+
+func (c *Client) ListTypedTokenHourDatas(ctx context.Context, opts *RequestOptions) (*typed.ListTokenHourDatasResponse, error) {
+	req, err := constructListQuery(TokenHourDataFields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.ListTokenHourDatasResponse{}, c, opts)
+}
@@ -0,0 +1,90 @@
+package unigraphclient
+
+import (
+	"context"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetTypedPoolById(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTypedTestServer(t, `{
+			"data": {
+				"pool": {
+					"id": "test",
+					"createdAtTimestamp": "1620250931",
+					"liquidity": "303015134493562686441",
+					"tick": "-201234",
+					"token0": {"id": "token0", "decimals": "18", "derivedETH": "1"}
+				}
+			}
+		}`)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.GetTypedPoolById(context.Background(), "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", resp.Pool.ID)
+		assert.Equal(t, time.Unix(1620250931, 0).UTC(), resp.Pool.CreatedAtTimestamp)
+		assert.Equal(t, 0, resp.Pool.Liquidity.Cmp(mustBigInt(t, "303015134493562686441")))
+		assert.Equal(t, int64(-201234), resp.Pool.Tick)
+		assert.Equal(t, int64(18), *resp.Pool.Token0.Decimals)
+		assert.Equal(t, "1", resp.Pool.Token0.DerivedETH.String())
+	})
+
+	t.Run("when a value can't be converted", func(t *testing.T) {
+		server := getTypedTestServer(t, `{"data": {"pool": {"id": "test", "liquidity": "not a number"}}}`)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		_, err := client.GetTypedPoolById(context.Background(), "test", nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unable to convert")
+	})
+}
+
+func TestListTypedSwaps(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTypedTestServer(t, `{
+			"data": {
+				"swaps": [
+					{"id": "test", "timestamp": "1700000000", "amountUSD": "1234.5678", "sqrtPriceX96": "1461446703485210103287273052203988822378723970341"}
+				]
+			}
+		}`)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.ListTypedSwaps(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Len(t, resp.Swaps, 1)
+		assert.Equal(t, time.Unix(1700000000, 0).UTC(), resp.Swaps[0].Timestamp)
+		assert.Equal(t, "1234.5678", resp.Swaps[0].AmountUSD.String())
+		assert.Equal(t, "1461446703485210103287273052203988822378723970341", resp.Swaps[0].SqrtPriceX96.String())
+	})
+}
+
+func getTypedTestServer(t *testing.T, body string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		io.WriteString(w, body)
+	}))
+}
+
+func mustBigInt(t *testing.T, s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big int %s", s)
+	}
+	return b
+}
@@ -1,4 +1,4 @@
-package converter
+package converter_test
 
 import (
 	"math"
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	unigraphclient "github.com/emersonmacro/go-uniswap-subgraph-client"
+	"github.com/emersonmacro/go-uniswap-subgraph-client/converter"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -15,7 +16,7 @@ func TestStringToBigInt(t *testing.T) {
 		s := "123456789"
 		expected := big.NewInt(123456789)
 
-		b, err := StringToBigInt(s)
+		b, err := converter.StringToBigInt(s)
 		assert.Nil(t, err)
 		assert.Equal(t, expected, b)
 	})
@@ -23,7 +24,7 @@ func TestStringToBigInt(t *testing.T) {
 	t.Run("when invalid", func(t *testing.T) {
 		s := "not a number"
 
-		_, err := StringToBigInt(s)
+		_, err := converter.StringToBigInt(s)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unable to convert")
 	})
@@ -34,7 +35,7 @@ func TestStringToBigFloat(t *testing.T) {
 		s := "12.3456"
 		expected := big.NewFloat(12.3456)
 
-		_, err := StringToBigFloat(s)
+		_, err := converter.StringToBigFloat(s)
 		assert.Nil(t, err)
 		assert.Equal(t, expected.String(), s)
 	})
@@ -42,7 +43,7 @@ func TestStringToBigFloat(t *testing.T) {
 	t.Run("when invalid", func(t *testing.T) {
 		s := "not a number"
 
-		_, err := StringToBigFloat(s)
+		_, err := converter.StringToBigFloat(s)
 		assert.NotNil(t, err)
 		assert.Contains(t, err.Error(), "unable to convert")
 	})
@@ -60,13 +61,13 @@ func TestModelToJsonBytes(t *testing.T) {
 
 		expected := []byte("{\"id\":\"test\",\"blockNumber\":\"1\",\"timestamp\":\"1\",\"gasUsed\":\"1\",\"gasPrice\":\"1\"}")
 
-		bytes, err := ModelToJsonBytes(model)
+		bytes, err := converter.ModelToJsonBytes(model)
 		assert.Nil(t, err)
 		assert.Equal(t, expected, bytes)
 	})
 
 	t.Run("when invalid", func(t *testing.T) {
-		_, err := ModelToJsonBytes(math.Inf(1))
+		_, err := converter.ModelToJsonBytes(math.Inf(1))
 		assert.NotNil(t, err)
 	})
 }
@@ -83,13 +84,13 @@ func TestModelToJsonString(t *testing.T) {
 
 		expected := "{\"id\":\"test\",\"blockNumber\":\"1\",\"timestamp\":\"1\",\"gasUsed\":\"1\",\"gasPrice\":\"1\"}"
 
-		bytes, err := ModelToJsonString(model)
+		bytes, err := converter.ModelToJsonString(model)
 		assert.Nil(t, err)
 		assert.Equal(t, expected, bytes)
 	})
 
 	t.Run("when invalid", func(t *testing.T) {
-		_, err := ModelToJsonString(math.Inf(1))
+		_, err := converter.ModelToJsonString(math.Inf(1))
 		assert.NotNil(t, err)
 	})
 }
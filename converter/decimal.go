@@ -0,0 +1,333 @@
+package converter
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// Decimal is an exact decimal number, stored as an unscaled big.Int and a base 10 scale (value = unscaled * 10^-scale).
+// unlike big.Float, it preserves the exact value of subgraph BigDecimal fields, e.g. "175360787979.8419091840895098379928".
+// Decimals are immutable, and the zero value is 0.
+type Decimal struct {
+	unscaled *big.Int
+	scale    int32
+}
+
+// rounding modes for Decimal.Round and Decimal.Div
+type RoundingMode int
+
+const (
+	RoundHalfUp   RoundingMode = iota // round to nearest, ties away from zero
+	RoundHalfEven                     // round to nearest, ties to even (banker's rounding)
+	RoundDown                         // round toward zero (truncate)
+	RoundUp                           // round away from zero
+	RoundFloor                        // round toward negative infinity
+	RoundCeiling                      // round toward positive infinity
+)
+
+// the largest scale (digits after the decimal point) and exponent magnitude of a parsed or computed Decimal. larger
+// values would make rescaling (e.g. "1e200000000") allocate and multiply absurdly large numbers.
+const MaxScale = 10000
+
+var (
+	ErrDivisionByZero = errors.New("converter error: decimal division by zero")
+	ErrScaleOverflow  = fmt.Errorf("converter error: decimal scale out of range [-%d, %d]", MaxScale, MaxScale)
+)
+
+// NewDecimal returns unscaled * 10^-scale. a negative scale multiplies unscaled by 10^-scale.
+func NewDecimal(unscaled *big.Int, scale int32) Decimal {
+	u := new(big.Int)
+	if unscaled != nil {
+		u.Set(unscaled)
+	}
+	if scale < 0 {
+		u.Mul(u, pow10(-scale))
+		scale = 0
+	}
+	return Decimal{unscaled: u, scale: scale}
+}
+
+func NewDecimalFromInt64(v int64) Decimal {
+	return Decimal{unscaled: big.NewInt(v), scale: 0}
+}
+
+// StringToDecimal parses a decimal string such as "-123.456" or "1.5e-7" without any loss of precision
+func StringToDecimal(s string) (Decimal, error) {
+	invalid := errors.New("converter error: unable to convert string to decimal")
+
+	mantissa := strings.TrimSpace(s)
+	var exp int64
+	if i := strings.IndexAny(mantissa, "eE"); i >= 0 {
+		e, err := strconv.ParseInt(mantissa[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, invalid
+		}
+		if e > MaxScale || e < -MaxScale {
+			return Decimal{}, fmt.Errorf("%w: %w", invalid, ErrScaleOverflow)
+		}
+		exp = e
+		mantissa = mantissa[:i]
+	}
+
+	negative := false
+	if strings.HasPrefix(mantissa, "-") || strings.HasPrefix(mantissa, "+") {
+		negative = mantissa[0] == '-'
+		mantissa = mantissa[1:]
+	}
+
+	intPart, fracPart, _ := strings.Cut(mantissa, ".")
+	digits := intPart + fracPart
+	if digits == "" {
+		return Decimal{}, invalid
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return Decimal{}, invalid
+		}
+	}
+
+	unscaled, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, invalid
+	}
+	if negative {
+		unscaled.Neg(unscaled)
+	}
+
+	scale := int64(len(fracPart)) - exp
+	if scale > MaxScale || scale < -MaxScale {
+		return Decimal{}, fmt.Errorf("%w: %w", invalid, ErrScaleOverflow)
+	}
+	return NewDecimal(unscaled, int32(scale)), nil
+}
+
+// returns the unscaled value, treating the zero value of Decimal as 0
+func (d Decimal) value() *big.Int {
+	if d.unscaled == nil {
+		return new(big.Int)
+	}
+	return d.unscaled
+}
+
+// Unscaled returns a copy of the unscaled value
+func (d Decimal) Unscaled() *big.Int {
+	return new(big.Int).Set(d.value())
+}
+
+// Scale returns the number of digits after the decimal point
+func (d Decimal) Scale() int32 {
+	return d.scale
+}
+
+// returns d with its unscaled value adjusted to the given (larger) scale
+func (d Decimal) rescale(scale int32) *big.Int {
+	u := new(big.Int).Set(d.value())
+	if scale > d.scale {
+		u.Mul(u, pow10(scale-d.scale))
+	}
+	return u
+}
+
+func (d Decimal) Add(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{unscaled: new(big.Int).Add(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+func (d Decimal) Sub(other Decimal) Decimal {
+	scale := max(d.scale, other.scale)
+	return Decimal{unscaled: new(big.Int).Sub(d.rescale(scale), other.rescale(scale)), scale: scale}
+}
+
+// Mul returns d * other, whose scale is the sum of both scales. it fails with ErrScaleOverflow if that's above MaxScale.
+func (d Decimal) Mul(other Decimal) (Decimal, error) {
+	scale := int64(d.scale) + int64(other.scale)
+	if scale > MaxScale {
+		return Decimal{}, ErrScaleOverflow
+	}
+	return Decimal{unscaled: new(big.Int).Mul(d.value(), other.value()), scale: int32(scale)}, nil
+}
+
+// Div returns d / other rounded to the given number of decimal places (at most MaxScale)
+func (d Decimal) Div(other Decimal, places int32, mode RoundingMode) (Decimal, error) {
+	if other.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	if places > MaxScale {
+		return Decimal{}, ErrScaleOverflow
+	}
+	if places < 0 {
+		places = 0
+	}
+	// d / other = (du * 10^-ds) / (ou * 10^-os), scaled up by 10^places
+	num := new(big.Int).Mul(d.value(), pow10(places+other.scale))
+	den := new(big.Int).Mul(other.value(), pow10(d.scale))
+	return Decimal{unscaled: roundQuo(num, den, mode), scale: places}, nil
+}
+
+func (d Decimal) Neg() Decimal {
+	return Decimal{unscaled: new(big.Int).Neg(d.value()), scale: d.scale}
+}
+
+func (d Decimal) Abs() Decimal {
+	return Decimal{unscaled: new(big.Int).Abs(d.value()), scale: d.scale}
+}
+
+// Round returns d rounded to the given number of decimal places. values with fewer places are returned unchanged.
+func (d Decimal) Round(places int32, mode RoundingMode) Decimal {
+	if places < 0 {
+		places = 0
+	}
+	if places >= d.scale {
+		return d
+	}
+	return Decimal{unscaled: roundQuo(d.value(), pow10(d.scale-places), mode), scale: places}
+}
+
+// Truncate returns d with any digits beyond the given number of decimal places dropped
+func (d Decimal) Truncate(places int32) Decimal {
+	return d.Round(places, RoundDown)
+}
+
+// Cmp compares d and other, returning -1, 0 or +1. values are compared numerically, so 1.50 and 1.5 are equal.
+func (d Decimal) Cmp(other Decimal) int {
+	scale := max(d.scale, other.scale)
+	return d.rescale(scale).Cmp(other.rescale(scale))
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) Sign() int {
+	return d.value().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.value()).String()
+	sign := ""
+	if d.Sign() < 0 {
+		sign = "-"
+	}
+	if d.scale == 0 {
+		return sign + digits
+	}
+	if len(digits) <= int(d.scale) {
+		digits = strings.Repeat("0", int(d.scale)-len(digits)+1) + digits
+	}
+	point := len(digits) - int(d.scale)
+	return sign + digits[:point] + "." + digits[point:]
+}
+
+// StringFixed formats d rounded (half up) to exactly the given number of decimal places
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	rounded := d.Round(places, RoundHalfUp)
+	return Decimal{unscaled: rounded.rescale(places), scale: places}.String()
+}
+
+// BigInt returns the integer part of d
+func (d Decimal) BigInt() *big.Int {
+	return d.Truncate(0).Unscaled()
+}
+
+// BigFloat returns d as a big.Float with the given precision (in bits)
+func (d Decimal) BigFloat(prec uint) *big.Float {
+	f := new(big.Float).SetPrec(prec).SetInt(d.value())
+	if d.scale > 0 {
+		f.Quo(f, new(big.Float).SetPrec(prec).SetInt(pow10(d.scale)))
+	}
+	return f
+}
+
+// Rat returns the exact value of d as a big.Rat
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.value(), pow10(d.scale))
+}
+
+// Float64 returns the nearest float64 to d
+func (d Decimal) Float64() float64 {
+	f, _ := strconv.ParseFloat(d.String(), 64)
+	return f
+}
+
+// MarshalJSON encodes d as a json string, matching how the subgraph returns BigDecimal values
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(strconv.Quote(d.String())), nil
+}
+
+// UnmarshalJSON decodes a json string or number. null leaves d unchanged.
+func (d *Decimal) UnmarshalJSON(data []byte) error {
+	s := string(data)
+	if s == "null" {
+		return nil
+	}
+	if unquoted, err := strconv.Unquote(s); err == nil {
+		s = unquoted
+	}
+	parsed, err := StringToDecimal(s)
+	if err != nil {
+		return fmt.Errorf("%w (%s)", err, string(data))
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := StringToDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+// divides num by den, rounding the quotient with the given mode
+func roundQuo(num *big.Int, den *big.Int, mode RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+
+	sign := num.Sign() * den.Sign()
+	half := new(big.Int).Abs(r)
+	half.Lsh(half, 1)
+	halfCmp := half.Cmp(new(big.Int).Abs(den))
+
+	var increment bool
+	switch mode {
+	case RoundHalfUp:
+		increment = halfCmp >= 0
+	case RoundHalfEven:
+		increment = halfCmp > 0 || (halfCmp == 0 && q.Bit(0) == 1)
+	case RoundDown:
+		increment = false
+	case RoundUp:
+		increment = true
+	case RoundFloor:
+		increment = sign < 0
+	case RoundCeiling:
+		increment = sign > 0
+	}
+
+	if increment {
+		q.Add(q, big.NewInt(int64(sign)))
+	}
+	return q
+}
+
+func pow10(n int32) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
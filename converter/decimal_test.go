@@ -0,0 +1,201 @@
+package converter
+
+import (
+	"encoding/json"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustDecimal(t *testing.T, s string) Decimal {
+	d, err := StringToDecimal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
+func TestStringToDecimal(t *testing.T) {
+	tests := map[string]struct {
+		s       string
+		want    string
+		wantErr bool
+	}{
+		"integer":             {s: "123", want: "123"},
+		"negative":            {s: "-1.50", want: "-1.50"},
+		"explicit plus":       {s: "+0.1", want: "0.1"},
+		"subgraph BigDecimal": {s: "175360787979.8419091840895098379928", want: "175360787979.8419091840895098379928"},
+		"leading point":       {s: ".5", want: "0.5"},
+		"negative exponent":   {s: "1.5e-7", want: "0.00000015"},
+		"positive exponent":   {s: "1.25E3", want: "1250"},
+		"empty":               {s: "", wantErr: true},
+		"not a number":        {s: "not a number", wantErr: true},
+		"two points":          {s: "1.2.3", wantErr: true},
+		"invalid exponent":    {s: "1e", wantErr: true},
+		"only sign and point": {s: "-.", wantErr: true},
+		"exponent too large":  {s: "1e200000000", wantErr: true},
+		"exponent too small":  {s: "1e-10001", wantErr: true},
+		"scale too large":     {s: "0." + strings.Repeat("1", MaxScale+1), wantErr: true},
+		"largest exponent":    {s: "1e10000", want: "1" + strings.Repeat("0", MaxScale)},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := StringToDecimal(test.s)
+
+			if test.wantErr {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), "unable to convert")
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.want, d.String())
+			}
+		})
+	}
+}
+
+func TestDecimalArithmetic(t *testing.T) {
+	a := mustDecimal(t, "0.1")
+	b := mustDecimal(t, "0.2")
+
+	assert.Equal(t, "0.3", a.Add(b).String())
+	assert.Equal(t, "-0.1", a.Sub(b).String())
+	product, err := a.Mul(b)
+	assert.Nil(t, err)
+	assert.Equal(t, "0.02", product.String())
+	assert.Equal(t, "-0.1", a.Neg().String())
+	assert.Equal(t, "0.1", a.Neg().Abs().String())
+	assert.Equal(t, "1.5", Decimal{}.Add(mustDecimal(t, "1.5")).String())
+
+	t.Run("when dividing", func(t *testing.T) {
+		got, err := mustDecimal(t, "1").Div(mustDecimal(t, "3"), 5, RoundHalfUp)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.33333", got.String())
+
+		got, err = mustDecimal(t, "2").Div(mustDecimal(t, "3"), 2, RoundHalfUp)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.67", got.String())
+
+		got, err = mustDecimal(t, "12.5").Div(mustDecimal(t, "0.5"), 0, RoundDown)
+		assert.Nil(t, err)
+		assert.Equal(t, "25", got.String())
+	})
+
+	t.Run("when the scale overflows", func(t *testing.T) {
+		small := NewDecimal(big.NewInt(1), MaxScale)
+		_, err := small.Mul(small)
+		assert.ErrorIs(t, err, ErrScaleOverflow)
+
+		_, err = a.Div(b, MaxScale+1, RoundHalfUp)
+		assert.ErrorIs(t, err, ErrScaleOverflow)
+	})
+
+	t.Run("when dividing by zero", func(t *testing.T) {
+		_, err := a.Div(Decimal{}, 2, RoundHalfUp)
+		assert.ErrorIs(t, err, ErrDivisionByZero)
+	})
+}
+
+func TestDecimalRound(t *testing.T) {
+	tests := map[string]struct {
+		s    string
+		mode RoundingMode
+		want string
+	}{
+		"half up (tie)":          {s: "2.5", mode: RoundHalfUp, want: "3"},
+		"half up (negative tie)": {s: "-2.5", mode: RoundHalfUp, want: "-3"},
+		"half even (tie down)":   {s: "2.5", mode: RoundHalfEven, want: "2"},
+		"half even (tie up)":     {s: "3.5", mode: RoundHalfEven, want: "4"},
+		"half even (negative)":   {s: "-3.5", mode: RoundHalfEven, want: "-4"},
+		"half even (not a tie)":  {s: "2.51", mode: RoundHalfEven, want: "3"},
+		"down":                   {s: "-2.9", mode: RoundDown, want: "-2"},
+		"up":                     {s: "2.1", mode: RoundUp, want: "3"},
+		"floor":                  {s: "-2.1", mode: RoundFloor, want: "-3"},
+		"ceiling":                {s: "-2.9", mode: RoundCeiling, want: "-2"},
+		"exact":                  {s: "2.0", mode: RoundUp, want: "2"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := mustDecimal(t, test.s).Round(0, test.mode)
+
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+
+	t.Run("when there are fewer places than requested", func(t *testing.T) {
+		assert.Equal(t, "1.5", mustDecimal(t, "1.5").Round(4, RoundHalfUp).String())
+	})
+
+	t.Run("when formatting with fixed places", func(t *testing.T) {
+		assert.Equal(t, "1.50", mustDecimal(t, "1.5").StringFixed(2))
+		assert.Equal(t, "0.13", mustDecimal(t, "0.125").StringFixed(2))
+		assert.Equal(t, "0.000", Decimal{}.StringFixed(3))
+	})
+
+	t.Run("when truncating", func(t *testing.T) {
+		assert.Equal(t, "175360787979.84", mustDecimal(t, "175360787979.8419091840895098379928").Truncate(2).String())
+	})
+}
+
+func TestDecimalCompare(t *testing.T) {
+	assert.True(t, mustDecimal(t, "1.50").Equal(mustDecimal(t, "1.5")))
+	assert.Equal(t, -1, mustDecimal(t, "1.49").Cmp(mustDecimal(t, "1.5")))
+	assert.Equal(t, 1, mustDecimal(t, "0").Cmp(mustDecimal(t, "-0.0001")))
+	assert.True(t, Decimal{}.IsZero())
+	assert.Equal(t, -1, mustDecimal(t, "-3").Sign())
+}
+
+func TestDecimalConversions(t *testing.T) {
+	d := mustDecimal(t, "-1234.5678")
+
+	assert.Equal(t, big.NewInt(-1234), d.BigInt())
+	assert.Equal(t, big.NewInt(-12345678), d.Unscaled())
+	assert.Equal(t, int32(4), d.Scale())
+	assert.Equal(t, -1234.5678, d.Float64())
+	assert.Equal(t, "-1234.5678", d.BigFloat(128).Text('f', 4))
+	assert.Equal(t, big.NewRat(-6172839, 5000), d.Rat())
+	assert.Equal(t, big.NewRat(0, 1), Decimal{}.Rat())
+	assert.Equal(t, "1000", NewDecimal(big.NewInt(1), -3).String())
+	assert.Equal(t, "0.001", NewDecimal(big.NewInt(1), 3).String())
+	assert.Equal(t, "42", NewDecimalFromInt64(42).String())
+}
+
+func TestDecimalMarshalling(t *testing.T) {
+	type volume struct {
+		VolumeUSD Decimal  `json:"volumeUSD"`
+		FeesUSD   *Decimal `json:"feesUSD"`
+	}
+
+	t.Run("when marshalling json", func(t *testing.T) {
+		b, err := json.Marshal(volume{VolumeUSD: mustDecimal(t, "12790614690.20250028366283473022774")})
+		assert.Nil(t, err)
+		assert.Equal(t, `{"volumeUSD":"12790614690.20250028366283473022774","feesUSD":null}`, string(b))
+	})
+
+	t.Run("when unmarshalling json", func(t *testing.T) {
+		var v volume
+		err := json.Unmarshal([]byte(`{"volumeUSD":"12790614690.20250028366283473022774","feesUSD":1.25}`), &v)
+		assert.Nil(t, err)
+		assert.Equal(t, "12790614690.20250028366283473022774", v.VolumeUSD.String())
+		assert.Equal(t, "1.25", v.FeesUSD.String())
+	})
+
+	t.Run("when unmarshalling invalid json", func(t *testing.T) {
+		var v volume
+		err := json.Unmarshal([]byte(`{"volumeUSD":"abc"}`), &v)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when marshalling text", func(t *testing.T) {
+		var d Decimal
+		err := d.UnmarshalText([]byte("0.0001"))
+		assert.Nil(t, err)
+
+		b, err := d.MarshalText()
+		assert.Nil(t, err)
+		assert.Equal(t, "0.0001", string(b))
+	})
+}
@@ -0,0 +1,227 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+// options for health checking and failover between the endpoints of a client created with NewFailoverClient
+type FailoverOptions struct {
+	HealthCheckInterval time.Duration // how often endpoints are health checked with a `_meta` query. checks are started in the background by the first request after the interval has passed. `30s` is the default.
+	HealthCheckTimeout  time.Duration // timeout of each endpoint's health check. checks aren't retried or rate limited. `5s` is the default.
+	MaxBlockLag         int           // endpoints indexed more than MaxBlockLag blocks behind the most recent endpoint are treated as unhealthy. 0 disables lag detection.
+}
+
+const (
+	defaultHealthCheckInterval = 30 * time.Second
+	defaultHealthCheckTimeout  = 5 * time.Second
+)
+
+// health of an endpoint as of its last health check or failed request
+type EndpointStatus struct {
+	Url       string
+	Healthy   bool
+	Block     int       // latest block indexed by the endpoint
+	Lag       int       // number of blocks behind the most recent endpoint
+	Err       error     // error from the last health check or failed request, if any
+	CheckedAt time.Time // time of the last health check
+}
+
+// describes how a request was served. see WithResponseInfo.
+type ResponseInfo struct {
+	Endpoint string // url of the endpoint that served the response (or returned the final error)
+	Attempts int    // number of endpoints tried
+}
+
+type responseInfoKey struct{}
+
+// WithResponseInfo returns a context that records how requests made with it are served into info, e.g.
+//
+//	info := &unigraphclient.ResponseInfo{}
+//	pool, err := client.GetPoolById(unigraphclient.WithResponseInfo(ctx, info), id, nil)
+//	fmt.Println(info.Endpoint)
+func WithResponseInfo(ctx context.Context, info *ResponseInfo) context.Context {
+	return context.WithValue(ctx, responseInfoKey{}, info)
+}
+
+type endpoint struct {
+	url     string
+	gql     *graphql.Client
+	check   *graphql.Client // sends health checks, without retries or the rate limiter
+	limiter *limiter
+
+	// guarded by healthState.mu
+	healthy   bool
+	block     int
+	lag       int
+	err       error
+	checkedAt time.Time
+	failedAt  time.Time // time of the last failed request
+}
+
+type healthState struct {
+	opts      FailoverOptions
+	policy    graphql.RetryPolicy // classifies the errors that fail over (ClientOptions.RetryPolicy, or the default policy)
+	mu        sync.Mutex
+	checking  bool
+	lastCheck time.Time
+}
+
+// NewFailoverClient creates a Client that sends each request to the first healthy endpoint in urls, failing over to
+// the next endpoint when a request fails with a transient error. endpoints are health checked with a `_meta` query every
+// opts.Failover.HealthCheckInterval, and endpoints lagging behind the others by more than opts.Failover.MaxBlockLag
+// blocks are only used once every healthy endpoint has failed. all endpoints must serve the same subgraph schema.
+func NewFailoverClient(urls []string, opts *ClientOptions) (*Client, error) {
+	if len(urls) == 0 {
+		return nil, errors.New("client options error: at least one endpoint url is required")
+	}
+	return newClient(urls, opts), nil
+}
+
+// CheckHealth health checks every endpoint now and returns their status in priority order. if a check is already
+// running (e.g. started in the background by a request), it returns the statuses as of the previous check.
+func (c *Client) CheckHealth(ctx context.Context) []EndpointStatus {
+	c.health.mu.Lock()
+	start := !c.health.checking
+	c.health.checking = true
+	c.health.mu.Unlock()
+	if start {
+		c.checkHealth(ctx)
+	}
+	return c.EndpointStatuses()
+}
+
+// EndpointStatuses returns the status of every endpoint in priority order, as of the last health check or failed request
+func (c *Client) EndpointStatuses() []EndpointStatus {
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+
+	statuses := make([]EndpointStatus, len(c.endpoints))
+	for i, e := range c.endpoints {
+		statuses[i] = EndpointStatus{
+			Url:       e.url,
+			Healthy:   e.healthy,
+			Block:     e.block,
+			Lag:       e.lag,
+			Err:       e.err,
+			CheckedAt: e.checkedAt,
+		}
+	}
+	return statuses
+}
+
+// returns the endpoints to try for a request: healthy endpoints in priority order, then unhealthy ones. when a health
+// check is due, it's started in the background, and the request is routed by the previous check.
+func (c *Client) route() []*endpoint {
+	if len(c.endpoints) == 1 {
+		return c.endpoints
+	}
+
+	interval := c.health.opts.HealthCheckInterval
+	if interval <= 0 {
+		interval = defaultHealthCheckInterval
+	}
+
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+	if !c.health.checking && time.Since(c.health.lastCheck) >= interval {
+		c.health.checking = true
+		go c.checkHealth(context.Background())
+	}
+
+	routed := slices.Clone(c.endpoints)
+	slices.SortStableFunc(routed, func(a, b *endpoint) int {
+		switch {
+		case a.healthy == b.healthy:
+			return 0
+		case a.healthy:
+			return -1
+		default:
+			return 1
+		}
+	})
+	return routed
+}
+
+// queries the latest block of every endpoint concurrently and updates their health. the caller must have set
+// healthState.checking, which is cleared when the check completes.
+func (c *Client) checkHealth(ctx context.Context) {
+	start := time.Now()
+	blocks := make([]int, len(c.endpoints))
+	errs := make([]error, len(c.endpoints))
+	var wg sync.WaitGroup
+	for i, e := range c.endpoints {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			blocks[i], errs[i] = c.endpointBlock(ctx, e)
+		}()
+	}
+	wg.Wait()
+
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+	c.health.checking = false
+	if ctx.Err() != nil {
+		// the check was cut short by the caller, so the results say nothing about the endpoints
+		return
+	}
+
+	now := time.Now()
+	head := slices.Max(blocks)
+	for i, e := range c.endpoints {
+		e.block = blocks[i]
+		e.lag = head - blocks[i]
+		e.checkedAt = now
+		if e.failedAt.After(start) {
+			// a request failed while the check was running, which is more recent than its result
+			continue
+		}
+		e.err = errs[i]
+		e.healthy = errs[i] == nil
+		if e.healthy && c.health.opts.MaxBlockLag > 0 && e.lag > c.health.opts.MaxBlockLag {
+			e.healthy = false
+		}
+	}
+	c.health.lastCheck = now
+}
+
+// queries the latest block indexed by a single endpoint, within the health check timeout
+func (c *Client) endpointBlock(ctx context.Context, e *endpoint) (int, error) {
+	timeout := c.health.opts.HealthCheckTimeout
+	if timeout <= 0 {
+		timeout = defaultHealthCheckTimeout
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	var raw map[string]any
+	if err := e.check.Run(ctx, graphql.NewRequest(metaQuery), &raw); err != nil {
+		c.logger.Warn("subgraph health check failed", slog.String("endpoint", e.url), slog.Any("error", err))
+		return 0, err
+	}
+
+	var resp MetaResponse
+	if err := decodeResponse(raw, &resp); err != nil {
+		return 0, err
+	}
+	if resp.Meta.Block.Number == 0 {
+		return 0, errors.New("health check error: endpoint returned no indexed block")
+	}
+	return resp.Meta.Block.Number, nil
+}
+
+// marks an endpoint unhealthy after a failed request, until its next health check
+func (c *Client) markUnhealthy(e *endpoint, err error) {
+	c.health.mu.Lock()
+	defer c.health.mu.Unlock()
+	e.healthy = false
+	e.err = err
+	e.failedAt = time.Now()
+}
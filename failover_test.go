@@ -0,0 +1,245 @@
+package unigraphclient
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewFailoverClient(t *testing.T) {
+	t.Run("when no urls are given", func(t *testing.T) {
+		_, err := NewFailoverClient(nil, nil)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when the primary endpoint is healthy", func(t *testing.T) {
+		primary := getFailoverTestServer(t, 100, false)
+		defer primary.Close()
+		secondary := getFailoverTestServer(t, 100, false)
+		defer secondary.Close()
+
+		client, err := NewFailoverClient([]string{primary.URL, secondary.URL}, nil)
+		assert.Nil(t, err)
+
+		info := &ResponseInfo{}
+		resp, err := client.GetFactoryById(WithResponseInfo(context.Background(), info), "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", resp.Factory.ID)
+		assert.Equal(t, primary.URL, info.Endpoint)
+		assert.Equal(t, 1, info.Attempts)
+	})
+
+	t.Run("when the primary endpoint fails", func(t *testing.T) {
+		primary := getFailoverTestServer(t, 100, true)
+		defer primary.Close()
+		secondary := getFailoverTestServer(t, 100, false)
+		defer secondary.Close()
+
+		client, err := NewFailoverClient([]string{primary.URL, secondary.URL}, nil)
+		assert.Nil(t, err)
+
+		info := &ResponseInfo{}
+		_, err = client.GetFactoryById(WithResponseInfo(context.Background(), info), "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, secondary.URL, info.Endpoint)
+
+		statuses := client.EndpointStatuses()
+		assert.False(t, statuses[0].Healthy)
+		assert.NotNil(t, statuses[0].Err)
+		assert.True(t, statuses[1].Healthy)
+	})
+
+	t.Run("when the primary endpoint returns a query error", func(t *testing.T) {
+		var primaryCalls, secondaryCalls int32
+		primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if strings.Contains(string(body), "_meta") {
+				io.WriteString(w, `{"data": {"_meta": {"block": {"number": 100}}}}`)
+				return
+			}
+			atomic.AddInt32(&primaryCalls, 1)
+			io.WriteString(w, `{"errors": [{"message": "Type Pool_filter has no field foo"}]}`)
+		}))
+		defer primary.Close()
+		secondary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			if !strings.Contains(string(body), "_meta") {
+				atomic.AddInt32(&secondaryCalls, 1)
+			}
+			io.WriteString(w, `{"data": {"_meta": {"block": {"number": 100}}}}`)
+		}))
+		defer secondary.Close()
+
+		client, err := NewFailoverClient([]string{primary.URL, secondary.URL}, nil)
+		assert.Nil(t, err)
+
+		info := &ResponseInfo{}
+		_, err = client.ListPools(WithResponseInfo(context.Background(), info), nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "Pool_filter")
+		assert.Equal(t, 1, info.Attempts)
+		assert.Equal(t, int32(1), primaryCalls)
+		assert.Equal(t, int32(0), secondaryCalls)
+
+		statuses := client.EndpointStatuses()
+		assert.True(t, statuses[0].Healthy)
+		assert.Nil(t, statuses[0].Err)
+	})
+
+	t.Run("when the primary endpoint lags", func(t *testing.T) {
+		primary := getFailoverTestServer(t, 90, false)
+		defer primary.Close()
+		secondary := getFailoverTestServer(t, 100, false)
+		defer secondary.Close()
+
+		client, err := NewFailoverClient([]string{primary.URL, secondary.URL}, &ClientOptions{Failover: &FailoverOptions{MaxBlockLag: 5}})
+		assert.Nil(t, err)
+		client.CheckHealth(context.Background())
+
+		info := &ResponseInfo{}
+		_, err = client.ListFactories(WithResponseInfo(context.Background(), info), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, secondary.URL, info.Endpoint)
+
+		statuses := client.EndpointStatuses()
+		assert.Equal(t, 10, statuses[0].Lag)
+		assert.False(t, statuses[0].Healthy)
+		assert.Equal(t, 100, statuses[1].Block)
+	})
+
+	t.Run("when every endpoint fails", func(t *testing.T) {
+		primary := getFailoverTestServer(t, 100, true)
+		defer primary.Close()
+		secondary := getFailoverTestServer(t, 100, true)
+		defer secondary.Close()
+
+		client, err := NewFailoverClient([]string{primary.URL, secondary.URL}, nil)
+		assert.Nil(t, err)
+
+		info := &ResponseInfo{}
+		_, err = client.GetFactoryById(WithResponseInfo(context.Background(), info), "test", nil)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "non-200 status code")
+		assert.Equal(t, 2, info.Attempts)
+	})
+
+	t.Run("when an endpoint is down for health checks", func(t *testing.T) {
+		down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		down.Close()
+		up := getFailoverTestServer(t, 100, false)
+		defer up.Close()
+
+		client, err := NewFailoverClient([]string{down.URL, up.URL}, nil)
+		assert.Nil(t, err)
+
+		statuses := client.CheckHealth(context.Background())
+		assert.False(t, statuses[0].Healthy)
+		assert.NotNil(t, statuses[0].Err)
+		assert.True(t, statuses[1].Healthy)
+		assert.Equal(t, 100, statuses[1].Block)
+		assert.False(t, statuses[1].CheckedAt.IsZero())
+	})
+
+	t.Run("when a health check times out", func(t *testing.T) {
+		slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			time.Sleep(200 * time.Millisecond)
+			io.WriteString(w, `{"data": {"_meta": {"block": {"number": 100}}}}`)
+		}))
+		defer slow.Close()
+		up := getFailoverTestServer(t, 100, false)
+		defer up.Close()
+
+		client, err := NewFailoverClient([]string{slow.URL, up.URL}, &ClientOptions{
+			Failover: &FailoverOptions{HealthCheckTimeout: 20 * time.Millisecond},
+		})
+		assert.Nil(t, err)
+
+		statuses := client.CheckHealth(context.Background())
+		assert.False(t, statuses[0].Healthy)
+		assert.ErrorIs(t, statuses[0].Err, context.DeadlineExceeded)
+		assert.True(t, statuses[1].Healthy)
+	})
+}
+
+func TestHealthCheckInterval(t *testing.T) {
+	var metaCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "_meta") {
+			atomic.AddInt32(&metaCalls, 1)
+			io.WriteString(w, `{"data": {"_meta": {"block": {"number": 100}}}}`)
+			return
+		}
+		io.WriteString(w, `{"data": {"factory": {"id": "test"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewFailoverClient([]string{server.URL, server.URL + "/"}, nil)
+	assert.Nil(t, err)
+
+	for i := 0; i < 3; i++ {
+		_, err := client.GetFactoryById(context.Background(), "test", nil)
+		assert.Nil(t, err)
+	}
+
+	// one health check of both endpoints, reused until the interval passes
+	assert.Eventually(t, func() bool { return atomic.LoadInt32(&metaCalls) == 2 }, time.Second, 5*time.Millisecond)
+	_, err = client.GetFactoryById(context.Background(), "test", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&metaCalls))
+}
+
+func TestHealthCheckInBackground(t *testing.T) {
+	var metaCalls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "_meta") {
+			// a failing endpoint that isn't retried by health checks
+			atomic.AddInt32(&metaCalls, 1)
+			time.Sleep(100 * time.Millisecond)
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"data": {"factory": {"id": "test"}}}`)
+	}))
+	defer server.Close()
+
+	client, err := NewFailoverClient([]string{server.URL, server.URL + "/"}, nil)
+	assert.Nil(t, err)
+
+	start := time.Now()
+	_, err = client.GetFactoryById(context.Background(), "test", nil)
+	assert.Nil(t, err)
+	assert.Less(t, time.Since(start), 90*time.Millisecond)
+
+	assert.Eventually(t, func() bool {
+		statuses := client.EndpointStatuses()
+		return !statuses[0].Healthy && !statuses[1].Healthy
+	}, time.Second, 5*time.Millisecond)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&metaCalls))
+}
+
+// serves _meta at the given block, and either factories or a 503
+func getFailoverTestServer(t *testing.T, block int, failing bool) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		assert.Nil(t, err)
+		if strings.Contains(string(body), "_meta") {
+			io.WriteString(w, fmt.Sprintf(`{"data": {"_meta": {"block": {"number": %d}}}}`, block))
+			return
+		}
+		if failing {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"data": {"factory": {"id": "test"}, "factories": [{"id": "test"}]}}`)
+	}))
+}
@@ -0,0 +1,82 @@
+package unigraphclient
+
+// helpers for composing Where filters. filters are validated against the model when the query is assembled.
+//
+//	where := And(
+//		Eq("pool", poolId),
+//		Gt("timestamp", "1700000000"),
+//		Or(
+//			Ref("token0", Eq("symbol", "WETH")),
+//			Ref("token1", Eq("symbol", "WETH")),
+//		),
+//	)
+
+func Eq(field string, value any) Where {
+	return Where{field: value}
+}
+
+func Not(field string, value any) Where {
+	return Where{field + "_not": value}
+}
+
+func Gt(field string, value any) Where {
+	return Where{field + "_gt": value}
+}
+
+func Gte(field string, value any) Where {
+	return Where{field + "_gte": value}
+}
+
+func Lt(field string, value any) Where {
+	return Where{field + "_lt": value}
+}
+
+func Lte(field string, value any) Where {
+	return Where{field + "_lte": value}
+}
+
+func In[T any](field string, values []T) Where {
+	return Where{field + "_in": values}
+}
+
+func NotIn[T any](field string, values []T) Where {
+	return Where{field + "_not_in": values}
+}
+
+func Contains(field string, value string) Where {
+	return Where{field + "_contains": value}
+}
+
+func StartsWith(field string, value string) Where {
+	return Where{field + "_starts_with": value}
+}
+
+func EndsWith(field string, value string) Where {
+	return Where{field + "_ends_with": value}
+}
+
+// matches entities that satisfy every filter
+func And(filters ...Where) Where {
+	return Where{"and": filters}
+}
+
+// matches entities that satisfy at least one filter
+func Or(filters ...Where) Where {
+	return Where{"or": filters}
+}
+
+// filters on the fields of a referenced entity e.g. Ref("token0", Eq("symbol", "WETH")) -> {token0_: {symbol: "WETH"}}
+func Ref(field string, filter Where) Where {
+	return Where{field + "_": filter}
+}
+
+// combines the predicates of several filters into a single filter. later filters overwrite duplicate keys.
+func Merge(filters ...Where) Where {
+	merged := Where{}
+	for _, filter := range filters {
+		for k, v := range filter {
+			merged[k] = v
+		}
+	}
+	return merged
+}
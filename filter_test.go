@@ -0,0 +1,76 @@
+package unigraphclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterBuilders(t *testing.T) {
+	tests := map[string]struct {
+		got  Where
+		want Where
+	}{
+		"Eq":         {got: Eq("symbol", "WETH"), want: Where{"symbol": "WETH"}},
+		"Not":        {got: Not("symbol", "WETH"), want: Where{"symbol_not": "WETH"}},
+		"Gt":         {got: Gt("timestamp", "1"), want: Where{"timestamp_gt": "1"}},
+		"Gte":        {got: Gte("timestamp", "1"), want: Where{"timestamp_gte": "1"}},
+		"Lt":         {got: Lt("timestamp", "1"), want: Where{"timestamp_lt": "1"}},
+		"Lte":        {got: Lte("timestamp", "1"), want: Where{"timestamp_lte": "1"}},
+		"In":         {got: In("id", []string{"a", "b"}), want: Where{"id_in": []string{"a", "b"}}},
+		"NotIn":      {got: NotIn("id", []string{"a"}), want: Where{"id_not_in": []string{"a"}}},
+		"Contains":   {got: Contains("name", "Wrapped"), want: Where{"name_contains": "Wrapped"}},
+		"StartsWith": {got: StartsWith("name", "W"), want: Where{"name_starts_with": "W"}},
+		"EndsWith":   {got: EndsWith("name", "r"), want: Where{"name_ends_with": "r"}},
+		"And": {
+			got:  And(Eq("a", 1), Eq("b", 2)),
+			want: Where{"and": []Where{{"a": 1}, {"b": 2}}},
+		},
+		"Or": {
+			got:  Or(Eq("a", 1), Eq("b", 2)),
+			want: Where{"or": []Where{{"a": 1}, {"b": 2}}},
+		},
+		"Ref": {
+			got:  Ref("token0", Eq("symbol", "WETH")),
+			want: Where{"token0_": Where{"symbol": "WETH"}},
+		},
+		"Merge": {
+			got:  Merge(Eq("a", 1), Gt("b", 2), Eq("a", 3)),
+			want: Where{"a": 3, "b_gt": 2},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, test.got)
+		})
+	}
+}
+
+func TestFilterComposition(t *testing.T) {
+	t.Run("when composed filter is valid", func(t *testing.T) {
+		where := And(
+			Eq("pool", "0x0"),
+			Gt("timestamp", "1700000000"),
+			Or(
+				Ref("token0", Eq("symbol", "WETH")),
+				Ref("token1", Eq("symbol", "WETH")),
+			),
+			Ref("pool", Merge(Eq("feeTier", "500"), Ref("token0", StartsWith("name", "Wrapped")))),
+		)
+		err := validateWhere(SwapFields, where)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("when nested filter field is invalid", func(t *testing.T) {
+		where := Or(
+			Eq("pool", "0x0"),
+			Ref("pool", Eq("symbol", "WETH")),
+		)
+		err := validateWhere(SwapFields, where)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized field given in opts.Where (symbol)")
+	})
+}
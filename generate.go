@@ -0,0 +1,4 @@
+package unigraphclient
+
+// the models, their field tables and the Get*/List* methods are generated from the subgraph schema
+//go:generate go run ./internal/modelgen -schema schema.graphql -dir .
@@ -0,0 +1,121 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"reflect"
+)
+
+// returned (wrapped) by Get when no entity has the given id
+var ErrNotFound = errors.New("not found")
+
+// registry of model types, mapping each type in Model to its name in modelMap. populated by models.go.
+var modelTypes map[reflect.Type]string = map[reflect.Type]string{}
+
+func registerModel[T Model, U Model](name string) {
+	modelTypes[reflect.TypeFor[T]()] = name
+	modelTypes[reflect.TypeFor[U]()] = name
+}
+
+// returns the fields of the model registered for T
+func fieldsOf[T Model]() (modelFields, error) {
+	t := reflect.TypeFor[T]()
+	name, ok := modelTypes[t]
+	if !ok {
+		return modelFields{}, fmt.Errorf("unregistered model type (%s)", t)
+	}
+	fields, ok := modelMap[name]
+	if !ok {
+		return modelFields{}, fmt.Errorf("unrecognized model (%s)", name)
+	}
+	return fields, nil
+}
+
+// Get fetches a single entity of any model by id, e.g. Get[Pool] or Get[typed.Pool]. returns an error wrapping
+// ErrNotFound if there is no entity with the id.
+func Get[T Model](ctx context.Context, c *Client, id string, opts *RequestOptions) (*T, error) {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return nil, err
+	}
+	req, err := constructByIdQuery(id, fields, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var entity *T
+	err = executeRequest(ctx, req, c, opts, func(resp interface{}) error {
+		data, _ := resp.(map[string]any)
+		if data[fields.name] == nil {
+			return nil
+		}
+		entity = new(T)
+		return decodeResponse(data[fields.name], entity)
+	})
+	if _, partial := err.(*PartialDataError); err != nil && !partial {
+		return nil, err
+	}
+	if entity == nil {
+		if err != nil {
+			return nil, err
+		}
+		return nil, fmt.Errorf("%w: %s %s", ErrNotFound, fields.name, id)
+	}
+	return entity, err
+}
+
+// GetList fetches a list of entities of any model, e.g. GetList[Swap] or GetList[typed.Swap]
+func GetList[T Model](ctx context.Context, c *Client, opts *RequestOptions) ([]T, error) {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return nil, err
+	}
+	req, err := constructListQuery(fields, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var entities []T
+	err = executeRequest(ctx, req, c, opts, func(resp interface{}) error {
+		data, _ := resp.(map[string]any)
+		return decodeResponse(data[pluralizeModelName(fields.name)], &entities)
+	})
+	if _, partial := err.(*PartialDataError); err != nil && !partial {
+		return nil, err
+	}
+	return entities, err
+}
+
+// Iter streams every entity of any model matching opts, paging with cursors, e.g. Iter[PositionSnapshot] or
+// Iter[typed.Swap]. opts.First is used as the page size.
+func Iter[T Model](ctx context.Context, c *Client, opts *RequestOptions) iter.Seq2[T, error] {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return func(yield func(T, error) bool) {
+			var zero T
+			yield(zero, err)
+		}
+	}
+	return iterate[T](ctx, c, fields, opts)
+}
+
+// ListAll collects every entity of any model matching opts, paging with cursors within the given limits, e.g.
+// ListAll[PositionSnapshot] or ListAll[typed.Swap]
+func ListAll[T Model](ctx context.Context, c *Client, opts *RequestOptions, limits *ListAllOptions) ([]T, *PageSummary, error) {
+	fields, err := fieldsOf[T]()
+	if err != nil {
+		return nil, nil, err
+	}
+	rows, summary, err := collectAll(ctx, c, fields, opts, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	entities := []T{}
+	if err := decodeResponse(rows, &entities); err != nil {
+		return nil, nil, err
+	}
+	return entities, summary, nil
+}
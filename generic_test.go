@@ -0,0 +1,160 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/typed"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModelRegistry(t *testing.T) {
+	t.Run("when every model is registered", func(t *testing.T) {
+		registered := map[string]int{}
+		for _, name := range modelTypes {
+			registered[name]++
+		}
+		for name, fields := range modelMap {
+			assert.Equal(t, name, fields.name)
+			assert.Equal(t, 2, registered[name], "model %s should have a default and a typed type", name)
+		}
+		assert.Len(t, registered, len(modelMap))
+	})
+}
+
+func TestGet(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTestServer(t, SuccessById, "positionSnapshot")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		snapshot, err := Get[PositionSnapshot](context.Background(), client, "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", snapshot.ID)
+	})
+
+	t.Run("when the model is typed", func(t *testing.T) {
+		server := getTestServer(t, SuccessById, "pool")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		pool, err := Get[typed.Pool](context.Background(), client, "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", pool.ID)
+	})
+
+	t.Run("when the entity is not found", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data":{"pool":null}}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		_, err := Get[Pool](context.Background(), client, "test", nil)
+		assert.True(t, errors.Is(err, ErrNotFound))
+	})
+
+	t.Run("when query construction fails", func(t *testing.T) {
+		client := NewClient("test", nil)
+		_, err := Get[Pool](context.Background(), client, "test", &RequestOptions{IncludeFields: []string{"not found"}})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetList(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTestServer(t, SuccessList, "positionSnapshot")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		snapshots, err := GetList[PositionSnapshot](context.Background(), client, nil)
+		assert.Nil(t, err)
+		assert.Len(t, snapshots, 1)
+		assert.Equal(t, "test", snapshots[0].ID)
+	})
+
+	t.Run("when the model is typed", func(t *testing.T) {
+		server := getTestServer(t, SuccessList, "flash")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		flashes, err := GetList[typed.Flash](context.Background(), client, nil)
+		assert.Nil(t, err)
+		assert.Len(t, flashes, 1)
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "swap")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		_, err := GetList[Swap](context.Background(), client, nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestIter(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		var ids []string
+		for swap, err := range Iter[typed.Swap](context.Background(), client, &RequestOptions{First: 10, Block: 100}) {
+			assert.Nil(t, err)
+			ids = append(ids, swap.ID)
+		}
+		assert.Len(t, ids, 25)
+		assert.Len(t, *requests, 3)
+	})
+}
+
+func TestListAll(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server, _ := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		swaps, summary, err := ListAll[Swap](context.Background(), client, &RequestOptions{First: 10, Block: 100}, &ListAllOptions{MaxRows: 15})
+		assert.Nil(t, err)
+		assert.Len(t, swaps, 15)
+		assert.Equal(t, "swap-0014", swaps[14].ID)
+		assert.Equal(t, 2, summary.Pages)
+		assert.False(t, summary.Complete)
+	})
+
+	t.Run("when limits are invalid", func(t *testing.T) {
+		client := NewClient("test", nil)
+		_, _, err := ListAll[PositionSnapshot](context.Background(), client, nil, &ListAllOptions{MaxRequests: -1})
+		assert.NotNil(t, err)
+	})
+}
+
+func TestGetPositionSnapshotById(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTestServer(t, SuccessById, "positionSnapshot")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		resp, err := client.GetPositionSnapshotById(context.Background(), "test", nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "test", resp.PositionSnapshot.ID)
+	})
+}
+
+func TestListPositionSnapshots(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getTestServer(t, SuccessList, "positionSnapshot")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		resp, err := client.ListPositionSnapshots(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Len(t, resp.PositionSnapshots, 1)
+	})
+}
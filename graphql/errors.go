@@ -0,0 +1,126 @@
+package graphql
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error is a single error returned by a GraphQL server.
+//
+// Errors can be classified with errors.Is against ErrIndexing, ErrTimeout,
+// ErrTooManySkip and ErrBlockNotFound.
+type Error struct {
+	Message    string         `json:"message"`
+	Path       []interface{}  `json:"path,omitempty"`       // path to the field that failed, e.g. ["pool", "token0", "symbol"] or ["pools", 3, "id"]
+	Locations  []Location     `json:"locations,omitempty"`  // locations in the query the error refers to
+	Extensions map[string]any `json:"extensions,omitempty"` // server specific details
+}
+
+// Location is a line and column (1-based) in a query.
+type Location struct {
+	Line   int `json:"line"`
+	Column int `json:"column"`
+}
+
+func (e Error) Error() string {
+	return "graphql: " + e.Message
+}
+
+// Is reports whether the error belongs to one of the error classes (ErrIndexing,
+// ErrTimeout, ErrTooManySkip or ErrBlockNotFound).
+func (e Error) Is(target error) bool {
+	for _, class := range errorClasses {
+		if class.err == target {
+			return class.matches(e.Message)
+		}
+	}
+	return false
+}
+
+// Classes of errors returned by graph-node, for use with errors.Is.
+var (
+	// ErrIndexing means the subgraph failed or has indexing errors.
+	ErrIndexing = errors.New("graphql: subgraph indexing error")
+
+	// ErrTimeout means the query took too long to execute on the server.
+	ErrTimeout = errors.New("graphql: query timed out")
+
+	// ErrTooManySkip means the skip argument exceeded the server's limit (5000 by default).
+	ErrTooManySkip = errors.New("graphql: skip argument too large")
+
+	// ErrBlockNotFound means the requested block is unknown or not indexed yet.
+	ErrBlockNotFound = errors.New("graphql: block not found")
+)
+
+type errorClass struct {
+	err      error
+	patterns []string // case insensitive substrings of matching error messages
+}
+
+func (c errorClass) matches(message string) bool {
+	message = strings.ToLower(message)
+	for _, pattern := range c.patterns {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+var errorClasses = []errorClass{
+	{ErrIndexing, []string{"indexing_error", "indexing error", "has indexing errors", "subgraph failed"}},
+	{ErrTimeout, []string{"timed out", "timeout", "took too long"}},
+	{ErrTooManySkip, []string{"`skip` argument must be between", "skip argument must be between"}},
+	{ErrBlockNotFound, []string{"not yet available", "block not found", "no block with that hash", "block hash not found", "unknown block"}},
+}
+
+// ResponseError is returned by Run when the server responds with GraphQL errors
+// or a non-200 status code. The individual errors can be inspected with errors.As
+// and errors.Is, which see every Error in Errors.
+type ResponseError struct {
+	Errors     []Error       // every error in the response. empty if the body couldn't be decoded.
+	StatusCode int           // http status code of the response
+	Body       []byte        // raw response body. only set for non-200 responses.
+	RetryAfter time.Duration // delay requested with a Retry-After header, if any
+}
+
+func newResponseError(res *http.Response, body []byte, errs []Error) *ResponseError {
+	e := &ResponseError{
+		Errors:     errs,
+		StatusCode: res.StatusCode,
+		RetryAfter: parseRetryAfter(res.Header.Get("Retry-After"), time.Now()),
+	}
+	if res.StatusCode != http.StatusOK {
+		e.Body = body
+	}
+	return e
+}
+
+func (e *ResponseError) Error() string {
+	switch len(e.Errors) {
+	case 0:
+		return fmt.Sprintf("graphql: server returned a non-200 status code: %v", e.StatusCode)
+	case 1:
+		return e.Errors[0].Error()
+	default:
+		return fmt.Sprintf("%s (and %d more errors)", e.Errors[0].Error(), len(e.Errors)-1)
+	}
+}
+
+// Unwrap returns every Error in the response.
+func (e *ResponseError) Unwrap() []error {
+	errs := make([]error, len(e.Errors))
+	for i := range e.Errors {
+		errs[i] = e.Errors[i]
+	}
+	return errs
+}
+
+// Is reports whether a gateway timeout (504) matches ErrTimeout. the individual
+// errors are matched through Unwrap.
+func (e *ResponseError) Is(target error) bool {
+	return target == ErrTimeout && e.StatusCode == http.StatusGatewayTimeout
+}
@@ -0,0 +1,129 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestResponseError(t *testing.T) {
+	t.Run("when the response has several errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			io.WriteString(w, `{
+				"data": {"pool": null},
+				"errors": [
+					{
+						"message": "Failed to decode token0",
+						"path": ["pool", "token0"],
+						"locations": [{"line": 3, "column": 5}],
+						"extensions": {"code": "INTERNAL"}
+					},
+					{"message": "Store error: query timed out"}
+				]
+			}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL)
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.Equal(t, "graphql: Failed to decode token0 (and 1 more errors)", err.Error())
+
+		var respErr *ResponseError
+		assert.True(t, errors.As(err, &respErr))
+		assert.Equal(t, http.StatusOK, respErr.StatusCode)
+		assert.Nil(t, respErr.Body)
+		assert.Len(t, respErr.Errors, 2)
+		assert.Equal(t, []interface{}{"pool", "token0"}, respErr.Errors[0].Path)
+		assert.Equal(t, []Location{{Line: 3, Column: 5}}, respErr.Errors[0].Locations)
+		assert.Equal(t, "INTERNAL", respErr.Errors[0].Extensions["code"])
+
+		var gqlErr Error
+		assert.True(t, errors.As(err, &gqlErr))
+		assert.Equal(t, "Failed to decode token0", gqlErr.Message)
+
+		assert.True(t, errors.Is(err, ErrTimeout))
+		assert.False(t, errors.Is(err, ErrBlockNotFound))
+	})
+
+	t.Run("when the server returns a non-200 status code", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGatewayTimeout)
+			io.WriteString(w, `upstream timed out`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL)
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+
+		var respErr *ResponseError
+		assert.True(t, errors.As(err, &respErr))
+		assert.Equal(t, http.StatusGatewayTimeout, respErr.StatusCode)
+		assert.Equal(t, "upstream timed out", string(respErr.Body))
+		assert.Empty(t, respErr.Errors)
+		assert.True(t, errors.Is(err, ErrTimeout))
+	})
+
+	t.Run("when a non-200 response has a json body without errors", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTooManyRequests)
+			io.WriteString(w, `{"message":"rate limited"}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL)
+
+		var responseData map[string]interface{}
+		err := client.Run(context.Background(), &Request{q: "query {}"}, &responseData)
+
+		var respErr *ResponseError
+		assert.True(t, errors.As(err, &respErr))
+		assert.Equal(t, http.StatusTooManyRequests, respErr.StatusCode)
+		assert.Equal(t, `{"message":"rate limited"}`, string(respErr.Body))
+		assert.Empty(t, respErr.Errors)
+	})
+}
+
+func TestErrorClasses(t *testing.T) {
+	tests := map[string]struct {
+		message string
+		want    error
+	}{
+		"indexing error": {
+			message: "indexing_error",
+			want:    ErrIndexing,
+		},
+		"timeout": {
+			message: "The query took too long to execute",
+			want:    ErrTimeout,
+		},
+		"too many skip": {
+			message: "The `skip` argument must be between 0 and 5000, but is 6000",
+			want:    ErrTooManySkip,
+		},
+		"block not indexed": {
+			message: "Failed to decode `block.number` value: `subgraph QmX has only indexed up to block number 100 and data for block number 200 is therefore not yet available`",
+			want:    ErrBlockNotFound,
+		},
+		"block hash not found": {
+			message: "Failed to decode `block.hash` value: `no block with that hash found`",
+			want:    ErrBlockNotFound,
+		},
+	}
+
+	classes := []error{ErrIndexing, ErrTimeout, ErrTooManySkip, ErrBlockNotFound}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := error(Error{Message: tc.message})
+			for _, class := range classes {
+				assert.Equal(t, class == tc.want, errors.Is(err, class), class.Error())
+			}
+		})
+	}
+}
@@ -36,6 +36,8 @@ package graphql
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
@@ -54,6 +56,12 @@ type Client struct {
 	// closeReq will close the request body immediately allowing for reuse of client
 	closeReq bool
 
+	// retryPolicy controls retries of failed requests. nil disables retries.
+	retryPolicy *RetryPolicy
+
+	// attemptHook is called before each attempt of a request, including retries. nil disables it.
+	attemptHook func(ctx context.Context) (func(), error)
+
 	// Log is called with various debug information.
 	// To log to standard out, use:
 	//  client.Log = func(s string) { log.Println(s) }
@@ -82,8 +90,9 @@ func (c *Client) logf(format string, args ...interface{}) {
 // Run executes the query and unmarshals the response from the data field
 // into the response object.
 // Pass in a nil response object to skip response parsing.
-// If the request fails or the server returns an error, the first error
-// will be returned.
+// If the request fails, an error is returned. If the server returns a non-200
+// status code or graphql errors, a *ResponseError is returned with the status
+// code, the raw body and every error in the response.
 func (c *Client) Run(ctx context.Context, req *Request, resp interface{}) error {
 	select {
 	case <-ctx.Done():
@@ -93,10 +102,26 @@ func (c *Client) Run(ctx context.Context, req *Request, resp interface{}) error
 	if len(req.files) > 0 && !c.useMultipartForm {
 		return errors.New("cannot send files with PostFields option")
 	}
+	run := c.runWithJSON
 	if c.useMultipartForm {
-		return c.runWithPostFields(ctx, req, resp)
+		run = c.runWithPostFields
+	}
+	if c.attemptHook != nil {
+		send := run
+		run = func(ctx context.Context, req *Request, resp interface{}) error {
+			done, err := c.attemptHook(ctx)
+			if err != nil {
+				return err
+			}
+			defer done()
+			return send(ctx, req, resp)
+		}
 	}
-	return c.runWithJSON(ctx, req, resp)
+	if len(req.files) > 0 {
+		// file readers can't be replayed, so requests with files are never retried
+		return run(ctx, req, resp)
+	}
+	return c.runWithRetries(ctx, req, resp, run)
 }
 
 func (c *Client) runWithJSON(ctx context.Context, req *Request, resp interface{}) error {
@@ -113,9 +138,6 @@ func (c *Client) runWithJSON(ctx context.Context, req *Request, resp interface{}
 	}
 	c.logf(">> variables: %v", req.vars)
 	c.logf(">> query: %s", req.q)
-	gr := &graphResponse{
-		Data: resp,
-	}
 	r, err := http.NewRequest(http.MethodPost, c.endpoint, &requestBody)
 	if err != nil {
 		return err
@@ -140,17 +162,7 @@ func (c *Client) runWithJSON(ctx context.Context, req *Request, resp interface{}
 		return errors.Wrap(err, "reading body")
 	}
 	c.logf("<< %s", buf.String())
-	if err := json.NewDecoder(&buf).Decode(&gr); err != nil {
-		if res.StatusCode != http.StatusOK {
-			return fmt.Errorf("graphql: server returned a non-200 status code: %v", res.StatusCode)
-		}
-		return errors.Wrap(err, "decoding response")
-	}
-	if len(gr.Errors) > 0 {
-		// return first error
-		return gr.Errors[0]
-	}
-	return nil
+	return decodeResponse(res, buf.Bytes(), resp)
 }
 
 func (c *Client) runWithPostFields(ctx context.Context, req *Request, resp interface{}) error {
@@ -184,9 +196,6 @@ func (c *Client) runWithPostFields(ctx context.Context, req *Request, resp inter
 	c.logf(">> variables: %s", variablesBuf.String())
 	c.logf(">> files: %d", len(req.files))
 	c.logf(">> query: %s", req.q)
-	gr := &graphResponse{
-		Data: resp,
-	}
 	r, err := http.NewRequest(http.MethodPost, c.endpoint, &requestBody)
 	if err != nil {
 		return err
@@ -211,17 +220,7 @@ func (c *Client) runWithPostFields(ctx context.Context, req *Request, resp inter
 		return errors.Wrap(err, "reading body")
 	}
 	c.logf("<< %s", buf.String())
-	if err := json.NewDecoder(&buf).Decode(&gr); err != nil {
-		if res.StatusCode != http.StatusOK {
-			return fmt.Errorf("graphql: server returned a non-200 status code: %v", res.StatusCode)
-		}
-		return errors.Wrap(err, "decoding response")
-	}
-	if len(gr.Errors) > 0 {
-		// return first error
-		return gr.Errors[0]
-	}
-	return nil
+	return decodeResponse(res, buf.Bytes(), resp)
 }
 
 // WithHTTPClient specifies the underlying http.Client to use when
@@ -249,21 +248,50 @@ func ImmediatelyCloseReqBody() ClientOption {
 	}
 }
 
-// ClientOption are functions that are passed into NewClient to
-// modify the behaviour of the Client.
-type ClientOption func(*Client)
-
-type graphErr struct {
-	Message string
+// WithRetryPolicy retries failed requests according to the given policy.
+//
+//	NewClient(endpoint, WithRetryPolicy(DefaultRetryPolicy()))
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(client *Client) {
+		client.retryPolicy = &policy
+	}
 }
 
-func (e graphErr) Error() string {
-	return "graphql: " + e.Message
+// WithAttemptHook calls hook before each attempt of a request, including retries, e.g.
+// to wait for a rate limiter. The attempt isn't sent if hook returns an error, which is
+// returned by Run. Otherwise the returned func is called when the attempt completes.
+func WithAttemptHook(hook func(ctx context.Context) (func(), error)) ClientOption {
+	return func(client *Client) {
+		client.attemptHook = hook
+	}
 }
 
+// ClientOption are functions that are passed into NewClient to
+// modify the behaviour of the Client.
+type ClientOption func(*Client)
+
 type graphResponse struct {
 	Data   interface{}
-	Errors []graphErr
+	Errors []Error
+}
+
+// decodes the data of a response body into resp. returns a *ResponseError if the
+// body has errors, or if the server returned a non-200 status code (whether or not
+// the body is valid json).
+func decodeResponse(res *http.Response, body []byte, resp interface{}) error {
+	gr := &graphResponse{
+		Data: resp,
+	}
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(&gr); err != nil {
+		if res.StatusCode != http.StatusOK {
+			return newResponseError(res, body, nil)
+		}
+		return errors.Wrap(err, "decoding response")
+	}
+	if res.StatusCode != http.StatusOK || len(gr.Errors) > 0 {
+		return newResponseError(res, body, gr.Errors)
+	}
+	return nil
 }
 
 // Request is a GraphQL request.
@@ -299,6 +327,19 @@ func (req *Request) Vars() map[string]interface{} {
 	return req.vars
 }
 
+// Hash gets a stable sha256 hash (hex encoded) of the query and variables of this
+// Request, suitable for cache keys and logging. Variables are hashed in their
+// json encoding, which sorts map keys.
+func (req *Request) Hash() string {
+	h := sha256.New()
+	io.WriteString(h, req.q)
+	h.Write([]byte{0})
+	if err := json.NewEncoder(h).Encode(req.vars); err != nil {
+		fmt.Fprintf(h, "%v", req.vars)
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
 // Files gets the files in this request.
 func (req *Request) Files() []File {
 	return req.files
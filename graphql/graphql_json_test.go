@@ -151,3 +151,25 @@ func TestHeader(t *testing.T) {
 
 	assert.Equal(t, resp.Value, "some data")
 }
+
+func TestRequestHash(t *testing.T) {
+	req1 := NewRequest("query {}")
+	req1.Var("b", map[string]interface{}{"y": 2, "x": 1})
+	req1.Var("a", "one")
+
+	req2 := NewRequest("query {}")
+	req2.Var("a", "one")
+	req2.Var("b", map[string]interface{}{"x": 1, "y": 2})
+
+	assert.Equal(t, req1.Hash(), req2.Hash())
+	assert.Len(t, req1.Hash(), 64)
+
+	req3 := NewRequest("query {}")
+	req3.Var("a", "two")
+	assert.NotEqual(t, req1.Hash(), req3.Hash())
+
+	req4 := NewRequest("query { other }")
+	req4.Var("a", "one")
+	req4.Var("b", map[string]interface{}{"x": 1, "y": 2})
+	assert.NotEqual(t, req1.Hash(), req4.Hash())
+}
@@ -0,0 +1,189 @@
+package graphql
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how a Client retries failed requests.
+//
+// A request is retried when the transport fails (e.g. connection reset), when the
+// server responds with one of RetryableStatusCodes, or when a GraphQL error message
+// contains one of RetryableMessages. Context cancellation is never retried.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first. Values below 2 disable retries.
+	MaxAttempts int
+
+	// InitialBackoff is the delay before the first retry (250ms if zero).
+	InitialBackoff time.Duration
+
+	// MaxBackoff caps the delay between attempts (10s if zero). A Retry-After header
+	// is honored even when it exceeds MaxBackoff.
+	MaxBackoff time.Duration
+
+	// Multiplier grows the delay after each attempt (2 if zero).
+	Multiplier float64
+
+	// Jitter is the fraction (0 to 1) of each delay that is randomized, to spread
+	// out retries from concurrent clients. Zero disables jitter.
+	Jitter float64
+
+	// RetryableStatusCodes are the http status codes that are retried.
+	// nil uses 429, 502, 503 and 504.
+	RetryableStatusCodes []int
+
+	// RetryableMessages are case insensitive substrings of GraphQL error messages that
+	// are retried. nil uses DefaultRetryableMessages.
+	RetryableMessages []string
+}
+
+// DefaultRetryableMessages are transient errors reported by hosted subgraph gateways and indexers.
+var DefaultRetryableMessages = []string{
+	"indexer unavailable",
+	"indexers unavailable",
+	"no healthy indexers",
+	"bad indexers",
+	"database unavailable",
+	"service unavailable",
+	"too many requests",
+}
+
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// DefaultRetryPolicy returns a policy of 4 attempts with exponential backoff from 250ms and 50% jitter.
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    4,
+		InitialBackoff: 250 * time.Millisecond,
+		MaxBackoff:     10 * time.Second,
+		Multiplier:     2,
+		Jitter:         0.5,
+	}
+}
+
+// parses a Retry-After header given in seconds or as an http date. returns 0 if absent or invalid.
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	header = strings.TrimSpace(header)
+	if header == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(header); err == nil && date.After(now) {
+		return date.Sub(now)
+	}
+	return 0
+}
+
+// Retryable reports whether err is transient under the policy: a transport error, one of
+// RetryableStatusCodes, or a GraphQL error matching RetryableMessages. Query errors (e.g. an
+// invalid filter) and context cancellation are not retryable.
+func (p *RetryPolicy) Retryable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+
+	var respErr *ResponseError
+	if errors.As(err, &respErr) {
+		codes := p.RetryableStatusCodes
+		if codes == nil {
+			codes = defaultRetryableStatusCodes
+		}
+		for _, code := range codes {
+			if respErr.StatusCode == code {
+				return true
+			}
+		}
+
+		messages := p.RetryableMessages
+		if messages == nil {
+			messages = DefaultRetryableMessages
+		}
+		for _, gqlErr := range respErr.Errors {
+			message := strings.ToLower(gqlErr.Message)
+			for _, m := range messages {
+				if strings.Contains(message, strings.ToLower(m)) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// returns the delay before the given retry (1 for the first retry)
+func (p *RetryPolicy) backoff(retry int, err error) time.Duration {
+	initial := p.InitialBackoff
+	if initial <= 0 {
+		initial = 250 * time.Millisecond
+	}
+	maxBackoff := p.MaxBackoff
+	if maxBackoff <= 0 {
+		maxBackoff = 10 * time.Second
+	}
+	multiplier := p.Multiplier
+	if multiplier <= 0 {
+		multiplier = 2
+	}
+
+	delay := float64(initial) * math.Pow(multiplier, float64(retry-1))
+	delay = math.Min(delay, float64(maxBackoff))
+	if jitter := math.Min(math.Max(p.Jitter, 0), 1); jitter > 0 {
+		delay -= delay * jitter * rand.Float64()
+	}
+
+	var respErr *ResponseError
+	if errors.As(err, &respErr) && respErr.RetryAfter > time.Duration(delay) {
+		return respErr.RetryAfter
+	}
+	return time.Duration(delay)
+}
+
+func (c *Client) runWithRetries(ctx context.Context, req *Request, resp interface{}, run func(context.Context, *Request, interface{}) error) error {
+	policy := c.retryPolicy
+	if policy == nil || policy.MaxAttempts < 2 {
+		return run(ctx, req, resp)
+	}
+
+	var err error
+	for attempt := 1; ; attempt++ {
+		err = run(ctx, req, resp)
+		if attempt >= policy.MaxAttempts || !policy.Retryable(err) {
+			return err
+		}
+
+		delay := policy.backoff(attempt, err)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			// the retry could never complete in time
+			return err
+		}
+		c.logf("retrying in %s (attempt %d of %d): %v", delay, attempt+1, policy.MaxAttempts, err)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
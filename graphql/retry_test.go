@@ -0,0 +1,218 @@
+package graphql
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryPolicy(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond}
+
+	t.Run("when the server recovers after a 503", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			b, err := io.ReadAll(r.Body)
+			assert.Nil(t, err)
+			assert.Equal(t, `{"query":"query {}","variables":null}`+"\n", string(b))
+			if calls == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				io.WriteString(w, `Service Unavailable`)
+				return
+			}
+			io.WriteString(w, `{"data": {"something": "yes"}}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		var responseData map[string]interface{}
+		err := client.Run(context.Background(), &Request{q: "query {}"}, &responseData)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, "yes", responseData["something"])
+	})
+
+	t.Run("when a 429 has a json body", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				io.WriteString(w, `{"message":"rate limited"}`)
+				return
+			}
+			io.WriteString(w, `{"data": {"something": "yes"}}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		var responseData map[string]interface{}
+		err := client.Run(context.Background(), &Request{q: "query {}"}, &responseData)
+		assert.Nil(t, err)
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, "yes", responseData["something"])
+	})
+
+	t.Run("when attempts are exhausted", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.Equal(t, 3, calls)
+		assert.Equal(t, "graphql: server returned a non-200 status code: 502", err.Error())
+	})
+
+	t.Run("when the error is not retryable", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, `{"errors": [{"message": "Type Query has no field foo"}]}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, "graphql: Type Query has no field foo", err.Error())
+	})
+
+	t.Run("when a graphql error is transient", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			if calls < 3 {
+				io.WriteString(w, `{"errors": [{"message": "Indexer unavailable, please retry"}]}`)
+				return
+			}
+			io.WriteString(w, `{"data": {"something": "yes"}}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		var responseData map[string]interface{}
+		err := client.Run(context.Background(), &Request{q: "query {}"}, &responseData)
+		assert.Nil(t, err)
+		assert.Equal(t, 3, calls)
+	})
+
+	t.Run("when Retry-After is given", func(t *testing.T) {
+		var calls []time.Time
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls = append(calls, time.Now())
+			if len(calls) == 1 {
+				w.Header().Set("Retry-After", "1")
+				w.WriteHeader(http.StatusTooManyRequests)
+				return
+			}
+			io.WriteString(w, `{"data": {}}`)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.Nil(t, err)
+		assert.Len(t, calls, 2)
+		assert.GreaterOrEqual(t, calls[1].Sub(calls[0]), time.Second)
+	})
+
+	t.Run("when Retry-After exceeds the context deadline", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.Header().Set("Retry-After", "120")
+			w.WriteHeader(http.StatusTooManyRequests)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+		defer cancel()
+		err := client.Run(ctx, &Request{q: "query {}"}, nil)
+		assert.Equal(t, 1, calls)
+		assert.Equal(t, "graphql: server returned a non-200 status code: 429", err.Error())
+	})
+
+	t.Run("when the connection fails", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+		srv.Close()
+
+		var logs []string
+		client := NewClient(srv.URL, WithRetryPolicy(policy))
+		client.Log = func(s string) { logs = append(logs, s) }
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.NotNil(t, err)
+		var retries int
+		for _, l := range logs {
+			if strings.HasPrefix(l, "retrying") {
+				retries++
+			}
+		}
+		assert.Equal(t, 2, retries)
+	})
+
+	t.Run("when no policy is set", func(t *testing.T) {
+		var calls int
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			calls++
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer srv.Close()
+
+		client := NewClient(srv.URL)
+
+		err := client.Run(context.Background(), &Request{q: "query {}"}, nil)
+		assert.NotNil(t, err)
+		assert.Equal(t, 1, calls)
+	})
+}
+
+func TestRetryBackoff(t *testing.T) {
+	t.Run("when backoff grows exponentially", func(t *testing.T) {
+		policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+		assert.Equal(t, 100*time.Millisecond, policy.backoff(1, nil))
+		assert.Equal(t, 200*time.Millisecond, policy.backoff(2, nil))
+		assert.Equal(t, 400*time.Millisecond, policy.backoff(3, nil))
+		assert.Equal(t, time.Second, policy.backoff(5, nil))
+	})
+
+	t.Run("when jitter is set", func(t *testing.T) {
+		policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, Jitter: 0.5}
+
+		for i := 0; i < 20; i++ {
+			delay := policy.backoff(1, nil)
+			assert.GreaterOrEqual(t, delay, 50*time.Millisecond)
+			assert.LessOrEqual(t, delay, 100*time.Millisecond)
+		}
+	})
+
+	t.Run("when Retry-After is an http date", func(t *testing.T) {
+		now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+		assert.Equal(t, 30*time.Second, parseRetryAfter("Mon, 01 Jan 2024 00:00:30 GMT", now))
+		assert.Equal(t, time.Duration(0), parseRetryAfter("Mon, 01 Jan 2023 00:00:30 GMT", now))
+		assert.Equal(t, 5*time.Second, parseRetryAfter("5", now))
+		assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+	})
+}
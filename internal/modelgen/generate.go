@@ -0,0 +1,447 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"slices"
+	"strings"
+	"text/template"
+)
+
+// graphql scalars. every other named type must be an object type in the schema.
+var scalars = map[string]bool{
+	"ID":         true,
+	"String":     true,
+	"Bytes":      true,
+	"Boolean":    true,
+	"Int":        true,
+	"Int8":       true,
+	"BigInt":     true,
+	"BigDecimal": true,
+	"Timestamp":  true,
+}
+
+// BigInt fields decoded into int64 by the typed models
+var typedInt64Fields = map[string]bool{
+	"tick":             true,
+	"tickIdx":          true,
+	"tickLower":        true,
+	"tickUpper":        true,
+	"decimals":         true,
+	"logIndex":         true,
+	"observationIndex": true,
+}
+
+// int64 fields decoded into *int64 by the typed models, where 0 is a valid value that must be told apart from a field
+// that wasn't queried (e.g. the decimals used by Pool.Price)
+var typedNullableFields = map[string]bool{
+	"decimals": true,
+}
+
+// Int fields decoded into time.Time by the typed models
+var typedTimeFields = map[string]bool{
+	"date":            true,
+	"periodStartUnix": true,
+}
+
+type fieldKind int
+
+const (
+	direct fieldKind = iota
+	reference
+	derived
+)
+
+type model struct {
+	Type     string // go type and graphql type e.g. PoolDayData
+	Name     string // model name e.g. poolDayData
+	Plural   string // e.g. poolDayDatas
+	GoPlural string // e.g. PoolDayDatas
+	Fields   []*modelField
+}
+
+type modelField struct {
+	Name      string // graphql field name
+	GoName    string
+	Kind      fieldKind
+	Ref       string // model name of a reference or derived field
+	Comment   string // graphql type and directives e.g. `[Swap!]! @derivedFrom(field: "pool")`
+	GoType    string // type in the default models
+	TypedType string // type in the typed models
+	OmitEmpty bool
+}
+
+func (m *model) fieldsOfKind(kind fieldKind) []*modelField {
+	var fields []*modelField
+	for _, f := range m.Fields {
+		if f.Kind == kind {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+func (m *model) Direct() []*modelField    { return m.fieldsOfKind(direct) }
+func (m *model) Reference() []*modelField { return m.fieldsOfKind(reference) }
+func (m *model) Derived() []*modelField   { return m.fieldsOfKind(derived) }
+
+// builds the models of the object types in the schema
+func buildModels(schema *Schema) ([]*model, error) {
+	var models []*model
+	for _, t := range schema.Types {
+		m := &model{
+			Type:     t.Name,
+			Name:     lowerFirst(t.Name),
+			Plural:   pluralize(lowerFirst(t.Name)),
+			GoPlural: pluralize(t.Name),
+		}
+		for _, f := range t.Fields {
+			field, err := buildField(schema, t, f)
+			if err != nil {
+				return nil, err
+			}
+			m.Fields = append(m.Fields, field)
+		}
+		models = append(models, m)
+	}
+	return models, nil
+}
+
+func buildField(schema *Schema, t *Type, f *Field) (*modelField, error) {
+	field := &modelField{
+		Name:    f.Name,
+		GoName:  goName(f.Name),
+		Comment: f.Type.String(),
+	}
+	for _, d := range f.Directives {
+		field.Comment += " " + d.String()
+	}
+
+	named := f.Type.Named()
+	if f.Type.List && f.Type.Elem.List {
+		return nil, fmt.Errorf("schema error: %s.%s: nested lists are not supported", t.Name, f.Name)
+	}
+	if scalars[named] {
+		field.Kind = direct
+		field.GoType = "string"
+		if named == "Boolean" {
+			field.GoType = "bool"
+		}
+		field.TypedType = typedScalar(f.Name, named)
+		if f.Type.List {
+			field.GoType = "[]" + field.GoType
+			field.TypedType = "[]" + field.TypedType
+		}
+		return field, nil
+	}
+
+	if schema.Type(named) == nil {
+		return nil, fmt.Errorf("schema error: %s.%s: unknown type %s", t.Name, f.Name, named)
+	}
+	field.Ref = lowerFirst(named)
+	field.Kind = reference
+	field.GoType = named
+	if f.Type.List {
+		// entity lists, whether @derivedFrom or stored on the entity (e.g. Token.whitelistPools), are unbounded, so
+		// they're derived fields: skipped by "*" and only selected explicitly, with first/skip arguments
+		field.Kind = derived
+		field.GoType = "[]" + named
+		field.OmitEmpty = true
+	}
+	field.TypedType = field.GoType
+	return field, nil
+}
+
+// returns the type of a scalar field in the typed models
+func typedScalar(name string, scalar string) string {
+	switch scalar {
+	case "BigInt":
+		switch {
+		case typedNullableFields[name]:
+			return "*int64"
+		case typedInt64Fields[name] || name == "blockNumber" || strings.HasSuffix(name, "BlockNumber"):
+			return "int64"
+		case name == "timestamp" || strings.HasSuffix(name, "Timestamp"):
+			return "time.Time"
+		}
+		return "*big.Int"
+	case "BigDecimal":
+		return "*converter.Decimal"
+	case "Int", "Int8":
+		if typedTimeFields[name] {
+			return "time.Time"
+		}
+		return "int64"
+	case "Timestamp":
+		return "time.Time"
+	case "Boolean":
+		return "bool"
+	}
+	return "string"
+}
+
+func goName(field string) string {
+	if field == "id" {
+		return "ID"
+	}
+	return strings.ToUpper(field[:1]) + field[1:]
+}
+
+func lowerFirst(s string) string {
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// pluralizes a model name the way graph-node names list queries e.g. factory -> factories, flash -> flashes,
+// uniswapDayData -> uniswapDayDatas
+func pluralize(name string) string {
+	switch {
+	case strings.HasSuffix(name, "y") && len(name) > 1 && !strings.ContainsRune("aeiou", rune(name[len(name)-2])):
+		return name[:len(name)-1] + "ies"
+	case strings.HasSuffix(name, "s") || strings.HasSuffix(name, "sh") || strings.HasSuffix(name, "ch") || strings.HasSuffix(name, "x") || strings.HasSuffix(name, "z"):
+		return name + "es"
+	}
+	return name + "s"
+}
+
+// the files generated from a schema, keyed by path relative to the module root
+func generate(schema *Schema, schemaPath string) (map[string][]byte, error) {
+	models, err := buildModels(schema)
+	if err != nil {
+		return nil, err
+	}
+	if len(models) == 0 {
+		return nil, fmt.Errorf("schema error: no object types in %s", schemaPath)
+	}
+
+	data := struct {
+		Schema string
+		Models []*model
+	}{schemaPath, models}
+
+	files := map[string][]byte{}
+	for path, tmpl := range templates {
+		var buf bytes.Buffer
+		if err := tmpl.Execute(&buf, data); err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		src, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: formatting generated code: %w", path, err)
+		}
+		files[path] = src
+	}
+	return files, nil
+}
+
+var funcs = template.FuncMap{
+	"last": func(i int, s []*model) bool { return i == len(s)-1 },
+	"imports": func(models []*model) [][]string {
+		// imports needed by the typed models, grouped into the standard library and this module
+		var std, module []string
+		for _, m := range models {
+			for _, f := range m.Fields {
+				if strings.Contains(f.TypedType, "big.") && !slices.Contains(std, "math/big") {
+					std = append(std, "math/big")
+				}
+				if strings.Contains(f.TypedType, "time.") && !slices.Contains(std, "time") {
+					std = append(std, "time")
+				}
+				if strings.Contains(f.TypedType, "converter.") && !slices.Contains(module, "github.com/emersonmacro/go-uniswap-subgraph-client/converter") {
+					module = append(module, "github.com/emersonmacro/go-uniswap-subgraph-client/converter")
+				}
+			}
+		}
+		slices.Sort(std)
+		var imports [][]string
+		for _, group := range [][]string{std, module} {
+			if len(group) > 0 {
+				imports = append(imports, group)
+			}
+		}
+		return imports
+	},
+}
+
+const header = `// Code generated by modelgen from {{.Schema}}. DO NOT EDIT.
+
+`
+
+var templates = map[string]*template.Template{
+	"models.go": template.Must(template.New("models.go").Funcs(funcs).Parse(header + `package unigraphclient
+
+import "github.com/emersonmacro/go-uniswap-subgraph-client/typed"
+
+// graphql types of the uniswap v3 subgraph (https://github.com/Uniswap/v3-subgraph/blob/main/schema.graphql)
+
+var modelMap map[string]modelFields = map[string]modelFields{
+{{- range .Models}}
+	"{{.Name}}": {{.Type}}Fields,
+{{- end}}
+}
+
+// plural names of the models, used as the root fields of List queries
+var modelPlurals map[string]string = map[string]string{
+{{- range .Models}}
+	"{{.Name}}": "{{.Plural}}",
+{{- end}}
+}
+
+// type constraint for Get and GetList: the models defined in models.go and their variants in the typed package
+type Model interface {
+	{{range $i, $m := .Models}}{{if $i}}
+		{{end}}{{.Type}} | typed.{{.Type}}{{if not (last $i $.Models)}} |{{end}}{{end}}
+}
+
+// type constraint for the responses of the models, see Response
+type modelResponse interface {
+	{{range $i, $m := .Models}}{{if $i}}
+		{{end}}{{.Type}}Response | List{{.GoPlural}}Response{{if not (last $i $.Models)}} |{{end}}{{end}}
+}
+
+func init() {
+{{- range .Models}}
+	registerModel[{{.Type}}, typed.{{.Type}}]("{{.Name}}")
+{{- end}}
+}
+{{range .Models}}
+type {{.Type}}Response struct {
+	{{.Type}} {{.Type}}
+}
+
+type List{{.GoPlural}}Response struct {
+	{{.GoPlural}} []{{.Type}}
+}
+
+type {{.Type}} struct {
+{{- range .Fields}}
+	{{.GoName}} {{.GoType}} ` + "`" + `json:"{{.Name}}{{if .OmitEmpty}},omitempty{{end}}"` + "`" + `
+{{- end}}
+}
+
+var {{.Type}}Fields modelFields = modelFields{
+	name: "{{.Name}}",
+	direct: []string{
+{{- range .Direct}}
+		"{{.Name}}", // {{.Comment}}
+{{- end}}
+	},
+{{- with .Reference}}
+	reference: map[string]string{
+{{- range .}}
+		"{{.Name}}": "{{.Ref}}", // {{.Comment}}
+{{- end}}
+	},
+{{- end}}
+{{- with .Derived}}
+	derived: map[string]string{
+{{- range .}}
+		"{{.Name}}": "{{.Ref}}", // {{.Comment}}
+{{- end}}
+	},
+{{- end}}
+}
+{{end}}`)),
+
+	"client_models.go": template.Must(template.New("client_models.go").Funcs(funcs).Parse(header + `package unigraphclient
+
+import (
+	"context"
+	"iter"
+)
+{{range .Models}}
+func (c *Client) Get{{.Type}}ById(ctx context.Context, id string, opts *RequestOptions) (*{{.Type}}Response, error) {
+	req, err := constructByIdQuery(id, {{.Type}}Fields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, {{.Type}}Response{}, c, opts)
+}
+
+func (c *Client) List{{.GoPlural}}(ctx context.Context, opts *RequestOptions) (*List{{.GoPlural}}Response, error) {
+	req, err := constructListQuery({{.Type}}Fields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, List{{.GoPlural}}Response{}, c, opts)
+}
+
+// Iter{{.GoPlural}} streams every {{.Name}} matching opts, paging with cursors. opts.First is used as the page size.
+func (c *Client) Iter{{.GoPlural}}(ctx context.Context, opts *RequestOptions) iter.Seq2[{{.Type}}, error] {
+	return iterate[{{.Type}}](ctx, c, {{.Type}}Fields, opts)
+}
+
+// ListAll{{.GoPlural}} collects every {{.Name}} matching opts into a single response, paging with cursors within the given limits.
+func (c *Client) ListAll{{.GoPlural}}(ctx context.Context, opts *RequestOptions, limits *ListAllOptions) (*List{{.GoPlural}}Response, *PageSummary, error) {
+	return fetchAll(ctx, c, {{.Type}}Fields, opts, limits, List{{.GoPlural}}Response{})
+}
+
+// List{{.GoPlural}}ByIds fetches {{.Plural}} by id with ` + "`id_in`" + ` filters, in chunks of up to opts.First (1000 by default) ids
+func (c *Client) List{{.GoPlural}}ByIds(ctx context.Context, ids []string, opts *RequestOptions) (*ListByIdsResponse[{{.Type}}], error) {
+	return listByIds[{{.Type}}](ctx, c, {{.Type}}Fields, ids, opts)
+}
+{{end}}`)),
+
+	"client_typed.go": template.Must(template.New("client_typed.go").Funcs(funcs).Parse(header + `package unigraphclient
+
+import (
+	"context"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/typed"
+)
+
+// typed variants of the Get*/List* methods, returning the models from the typed package
+{{range .Models}}
+func (c *Client) GetTyped{{.Type}}ById(ctx context.Context, id string, opts *RequestOptions) (*typed.{{.Type}}Response, error) {
+	req, err := constructByIdQuery(id, {{.Type}}Fields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.{{.Type}}Response{}, c, opts)
+}
+
+func (c *Client) ListTyped{{.GoPlural}}(ctx context.Context, opts *RequestOptions) (*typed.List{{.GoPlural}}Response, error) {
+	req, err := constructListQuery({{.Type}}Fields, opts)
+	if err != nil {
+		return nil, err
+	}
+	return executeRequestAndConvert(ctx, req, typed.List{{.GoPlural}}Response{}, c, opts)
+}
+{{end}}`)),
+
+	"typed/models.go": template.Must(template.New("typed/models.go").Funcs(funcs).Parse(header + `package typed
+{{with imports .Models}}
+import (
+{{- range $i, $group := .}}{{if $i}}
+{{end}}
+{{- range $group}}
+	"{{.}}"
+{{- end}}
+{{- end}}
+)
+{{end}}
+// typed variants of the uniswap models defined in the root package. BigInt fields are decoded into *big.Int
+// (or int64 for ticks, block numbers and log indexes, and *int64 for decimals), BigDecimal fields into exact
+// *converter.Decimal values, and timestamps into time.Time.
+
+// type constraint for the typed responses, used by executeRequestAndConvert in the root package
+type Response interface {
+	{{range $i, $m := .Models}}{{if $i}}
+		{{end}}{{.Type}}Response | List{{.GoPlural}}Response{{if not (last $i $.Models)}} |{{end}}{{end}}
+}
+{{range .Models}}
+type {{.Type}}Response struct {
+	{{.Type}} {{.Type}}
+}
+
+type List{{.GoPlural}}Response struct {
+	{{.GoPlural}} []{{.Type}}
+}
+
+type {{.Type}} struct {
+{{- range .Fields}}
+	{{.GoName}} {{.TypedType}} ` + "`" + `json:"{{.Name}}{{if .OmitEmpty}},omitempty{{end}}"` + "`" + `
+{{- end}}
+}
+{{end}}`)),
+}
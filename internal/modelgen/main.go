@@ -0,0 +1,52 @@
+// modelgen generates the uniswap models, their field tables and the client methods from a graphql schema.
+//
+// usage (from the module root, see generate.go):
+//
+//	go run ./internal/modelgen -schema schema.graphql -dir .
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+func main() {
+	schemaPath := flag.String("schema", "schema.graphql", "path of the graphql schema")
+	dir := flag.String("dir", ".", "root directory of the generated files")
+	flag.Parse()
+
+	if err := run(*schemaPath, *dir); err != nil {
+		fmt.Fprintln(os.Stderr, "modelgen:", err)
+		os.Exit(1)
+	}
+}
+
+func run(schemaPath string, dir string) error {
+	src, err := os.ReadFile(schemaPath)
+	if err != nil {
+		return err
+	}
+	schema, err := ParseSchema(string(src))
+	if err != nil {
+		return err
+	}
+	files, err := generate(schema, filepath.Base(schemaPath))
+	if err != nil {
+		return err
+	}
+
+	paths := make([]string, 0, len(files))
+	for path := range files {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		if err := os.WriteFile(filepath.Join(dir, filepath.FromSlash(path)), files[path], 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
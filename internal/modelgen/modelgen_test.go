@@ -0,0 +1,130 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+func TestGenerate(t *testing.T) {
+	t.Run("when compared with the golden files", func(t *testing.T) {
+		src, err := os.ReadFile("testdata/schema.graphql")
+		assert.Nil(t, err)
+		schema, err := ParseSchema(string(src))
+		assert.Nil(t, err)
+
+		files, err := generate(schema, "schema.graphql")
+		assert.Nil(t, err)
+		assert.Len(t, files, len(templates))
+
+		for path, got := range files {
+			golden := filepath.Join("testdata", "golden", strings.ReplaceAll(path, "/", "_")+".golden")
+			if *update {
+				assert.Nil(t, os.MkdirAll(filepath.Dir(golden), 0o755))
+				assert.Nil(t, os.WriteFile(golden, got, 0o644))
+				continue
+			}
+			want, err := os.ReadFile(golden)
+			assert.Nil(t, err)
+			assert.Equal(t, string(want), string(got), "%s is out of date (run `go test ./internal/modelgen -update`)", golden)
+		}
+	})
+
+	t.Run("when compared with the checked-in files", func(t *testing.T) {
+		// the generated files in the module must match the schema, i.e. `go generate` was run after the last change
+		src, err := os.ReadFile("../../schema.graphql")
+		assert.Nil(t, err)
+		schema, err := ParseSchema(string(src))
+		assert.Nil(t, err)
+
+		files, err := generate(schema, "schema.graphql")
+		assert.Nil(t, err)
+		for path, got := range files {
+			want, err := os.ReadFile(filepath.Join("../..", filepath.FromSlash(path)))
+			assert.Nil(t, err)
+			assert.Equal(t, string(want), string(got), "%s is out of date (run `go generate`)", path)
+		}
+	})
+
+	t.Run("when a field has an unknown type", func(t *testing.T) {
+		schema, err := ParseSchema("type Pool {\n  id: ID!\n  token0: Token!\n}")
+		assert.Nil(t, err)
+		_, err = generate(schema, "schema.graphql")
+		assert.EqualError(t, err, "schema error: Pool.token0: unknown type Token")
+	})
+
+	t.Run("when there are no object types", func(t *testing.T) {
+		schema, err := ParseSchema("enum Side { Buy Sell }")
+		assert.Nil(t, err)
+		_, err = generate(schema, "schema.graphql")
+		assert.NotNil(t, err)
+	})
+}
+
+func TestParseSchema(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		schema, err := ParseSchema(`
+			type Pool @entity {
+				id: ID! # the pool address
+				ticks(first: Int = 100): [Tick!]! @derivedFrom(field: "pool")
+			}
+			union Entity = Pool
+				| Tick
+			type Tick { id: ID!, pool: Pool }
+		`)
+		assert.Nil(t, err)
+		assert.Len(t, schema.Types, 2)
+
+		pool := schema.Type("Pool")
+		assert.Equal(t, "entity", pool.Directives[0].Name)
+		assert.Len(t, pool.Fields, 2)
+		ticks := pool.Fields[1]
+		assert.Equal(t, "ticks", ticks.Name)
+		assert.Equal(t, "[Tick!]!", ticks.Type.String())
+		assert.Equal(t, "Tick", ticks.Type.Named())
+		assert.Equal(t, `@derivedFrom(field: "pool")`, ticks.Directives[0].String())
+
+		tick := schema.Type("Tick")
+		assert.Equal(t, "Pool", tick.Fields[1].Type.String())
+	})
+
+	tests := []struct {
+		name    string
+		src     string
+		wantErr string
+	}{
+		{"when a type is unterminated", "type Pool {\n  id: ID!\n", "schema error: line 3: unterminated type Pool"},
+		{"when a field has no type", "type Pool {\n  id\n}", `schema error: line 3: expected ":", got "}"`},
+		{"when a type is defined twice", "type Pool { id: ID! }\ntype Pool { id: ID! }", "schema error: type Pool is defined more than once"},
+		{"when a string is unterminated", "type Pool @entity(name: \"pool) { id: ID! }", "schema error: line 1: unterminated string"},
+		{"when a definition is unrecognized", "query { pools }", `schema error: line 1: unexpected "query"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseSchema(tt.src)
+			assert.EqualError(t, err, tt.wantErr)
+		})
+	}
+}
+
+func TestPluralize(t *testing.T) {
+	tests := map[string]string{
+		"pool":           "pools",
+		"factory":        "factories",
+		"flash":          "flashes",
+		"day":            "days",
+		"uniswapDayData": "uniswapDayDatas",
+		"Factory":        "Factories",
+	}
+	for name, want := range tests {
+		t.Run("when the name is "+name, func(t *testing.T) {
+			assert.Equal(t, want, pluralize(name))
+		})
+	}
+}
@@ -0,0 +1,447 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// a parsed schema.graphql. only object types (`type X {...}`) are kept; enums, scalars, interfaces and inputs are
+// skipped.
+type Schema struct {
+	Types []*Type
+}
+
+type Type struct {
+	Name       string
+	Directives []Directive
+	Fields     []*Field
+}
+
+type Field struct {
+	Name       string
+	Type       TypeRef
+	Directives []Directive
+}
+
+// a field type e.g. `[Swap!]!` is {List: true, NonNull: true, Elem: {Name: "Swap", NonNull: true}}
+type TypeRef struct {
+	Name    string // named type, empty for lists
+	List    bool
+	Elem    *TypeRef // element type of a list
+	NonNull bool
+}
+
+type Directive struct {
+	Name string
+	Args []Arg
+}
+
+type Arg struct {
+	Name  string
+	Value string // raw value, including quotes for strings
+}
+
+// String returns the type as written in the schema e.g. `[Swap!]!`
+func (t TypeRef) String() string {
+	s := t.Name
+	if t.List {
+		s = "[" + t.Elem.String() + "]"
+	}
+	if t.NonNull {
+		s += "!"
+	}
+	return s
+}
+
+// Named returns the named type of the field, unwrapping lists
+func (t TypeRef) Named() string {
+	if t.List {
+		return t.Elem.Named()
+	}
+	return t.Name
+}
+
+// String returns the directive as written in the schema e.g. `@derivedFrom(field: "pool")`
+func (d Directive) String() string {
+	if len(d.Args) == 0 {
+		return "@" + d.Name
+	}
+	args := make([]string, len(d.Args))
+	for i, arg := range d.Args {
+		args[i] = arg.Name + ": " + arg.Value
+	}
+	return "@" + d.Name + "(" + strings.Join(args, ", ") + ")"
+}
+
+// Type returns the object type with the given name, or nil
+func (s *Schema) Type(name string) *Type {
+	for _, t := range s.Types {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+// ParseSchema parses the object types of a graphql schema (SDL)
+func ParseSchema(src string) (*Schema, error) {
+	p := &parser{lex: newLexer(src)}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	schema := &Schema{}
+	for p.tok.kind != tokEOF {
+		if p.tok.kind == tokString {
+			// description
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		if p.tok.kind != tokName {
+			return nil, p.errorf("expected a definition, got %q", p.tok.text)
+		}
+
+		switch p.tok.text {
+		case "type":
+			t, err := p.parseType()
+			if err != nil {
+				return nil, err
+			}
+			if schema.Type(t.Name) != nil {
+				return nil, fmt.Errorf("schema error: type %s is defined more than once", t.Name)
+			}
+			schema.Types = append(schema.Types, t)
+		case "enum", "interface", "input", "scalar", "union", "directive", "schema", "extend":
+			if err := p.skipDefinition(); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, p.errorf("unexpected %q", p.tok.text)
+		}
+	}
+	return schema, nil
+}
+
+type tokenKind int
+
+const (
+	tokEOF tokenKind = iota
+	tokName
+	tokString
+	tokPunct
+)
+
+type token struct {
+	kind tokenKind
+	text string
+	line int
+}
+
+type lexer struct {
+	src  []rune
+	pos  int
+	line int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: []rune(src), line: 1}
+}
+
+func (l *lexer) next() (token, error) {
+	// skip whitespace, commas and comments
+	for l.pos < len(l.src) {
+		r := l.src[l.pos]
+		if r == '\n' {
+			l.line++
+		}
+		if unicode.IsSpace(r) || r == ',' || r == '\uFEFF' {
+			l.pos++
+			continue
+		}
+		if r == '#' {
+			for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+				l.pos++
+			}
+			continue
+		}
+		break
+	}
+	if l.pos >= len(l.src) {
+		return token{kind: tokEOF, line: l.line}, nil
+	}
+
+	start := l.pos
+	r := l.src[l.pos]
+	switch {
+	case r == '_' || unicode.IsLetter(r):
+		for l.pos < len(l.src) && (l.src[l.pos] == '_' || unicode.IsLetter(l.src[l.pos]) || unicode.IsDigit(l.src[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokName, text: string(l.src[start:l.pos]), line: l.line}, nil
+	case r == '-' || unicode.IsDigit(r):
+		l.pos++
+		for l.pos < len(l.src) && (unicode.IsDigit(l.src[l.pos]) || strings.ContainsRune(".eE+-", l.src[l.pos])) {
+			l.pos++
+		}
+		return token{kind: tokName, text: string(l.src[start:l.pos]), line: l.line}, nil
+	case r == '"':
+		if l.hasPrefix(`"""`, l.pos) {
+			end := l.pos + 3
+			for end < len(l.src) && !l.hasPrefix(`"""`, end) {
+				end++
+			}
+			if end >= len(l.src) {
+				return token{}, fmt.Errorf("schema error: line %d: unterminated block string", l.line)
+			}
+			text := string(l.src[l.pos : end+3])
+			l.line += strings.Count(text, "\n")
+			l.pos = end + 3
+			return token{kind: tokString, text: text, line: l.line}, nil
+		}
+		l.pos++
+		for l.pos < len(l.src) && l.src[l.pos] != '"' {
+			if l.src[l.pos] == '\\' {
+				l.pos++
+			}
+			if l.pos < len(l.src) && l.src[l.pos] == '\n' {
+				return token{}, fmt.Errorf("schema error: line %d: unterminated string", l.line)
+			}
+			l.pos++
+		}
+		if l.pos >= len(l.src) {
+			return token{}, fmt.Errorf("schema error: line %d: unterminated string", l.line)
+		}
+		l.pos++
+		return token{kind: tokString, text: string(l.src[start:l.pos]), line: l.line}, nil
+	case strings.ContainsRune("{}()[]:!@=|&$", r):
+		l.pos++
+		return token{kind: tokPunct, text: string(r), line: l.line}, nil
+	case l.hasPrefix("...", l.pos):
+		l.pos += 3
+		return token{kind: tokPunct, text: "...", line: l.line}, nil
+	}
+	return token{}, fmt.Errorf("schema error: line %d: unexpected character %q", l.line, r)
+}
+
+func (l *lexer) hasPrefix(s string, at int) bool {
+	return strings.HasPrefix(string(l.src[at:min(at+len(s), len(l.src))]), s)
+}
+
+type parser struct {
+	lex *lexer
+	tok token
+}
+
+func (p *parser) next() error {
+	tok, err := p.lex.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+func (p *parser) errorf(format string, args ...any) error {
+	return fmt.Errorf("schema error: line %d: %s", p.tok.line, fmt.Sprintf(format, args...))
+}
+
+func (p *parser) expect(text string) error {
+	if p.tok.kind != tokPunct || p.tok.text != text {
+		return p.errorf("expected %q, got %q", text, p.tok.text)
+	}
+	return p.next()
+}
+
+func (p *parser) name() (string, error) {
+	if p.tok.kind != tokName {
+		return "", p.errorf("expected a name, got %q", p.tok.text)
+	}
+	name := p.tok.text
+	return name, p.next()
+}
+
+func (p *parser) is(text string) bool {
+	return p.tok.kind == tokPunct && p.tok.text == text
+}
+
+func (p *parser) parseType() (*Type, error) {
+	if err := p.next(); err != nil { // type
+		return nil, err
+	}
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	t := &Type{Name: name}
+
+	if p.tok.kind == tokName && p.tok.text == "implements" {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		for p.tok.kind == tokName || p.is("&") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if t.Directives, err = p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+
+	for !p.is("}") {
+		if p.tok.kind == tokEOF {
+			return nil, p.errorf("unterminated type %s", t.Name)
+		}
+		if p.tok.kind == tokString {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		field, err := p.parseField()
+		if err != nil {
+			return nil, err
+		}
+		t.Fields = append(t.Fields, field)
+	}
+	return t, p.next()
+}
+
+func (p *parser) parseField() (*Field, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	field := &Field{Name: name}
+	if p.is("(") {
+		// arguments aren't used by the generated code
+		if err := p.skipBalanced("(", ")"); err != nil {
+			return nil, err
+		}
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	if field.Type, err = p.parseTypeRef(); err != nil {
+		return nil, err
+	}
+	if field.Directives, err = p.parseDirectives(); err != nil {
+		return nil, err
+	}
+	return field, nil
+}
+
+func (p *parser) parseTypeRef() (TypeRef, error) {
+	var t TypeRef
+	if p.is("[") {
+		if err := p.next(); err != nil {
+			return t, err
+		}
+		elem, err := p.parseTypeRef()
+		if err != nil {
+			return t, err
+		}
+		if err := p.expect("]"); err != nil {
+			return t, err
+		}
+		t = TypeRef{List: true, Elem: &elem}
+	} else {
+		name, err := p.name()
+		if err != nil {
+			return t, err
+		}
+		t = TypeRef{Name: name}
+	}
+	if p.is("!") {
+		t.NonNull = true
+		return t, p.next()
+	}
+	return t, nil
+}
+
+func (p *parser) parseDirectives() ([]Directive, error) {
+	var directives []Directive
+	for p.is("@") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		d := Directive{Name: name}
+		if p.is("(") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			for !p.is(")") {
+				argName, err := p.name()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expect(":"); err != nil {
+					return nil, err
+				}
+				if p.tok.kind != tokName && p.tok.kind != tokString {
+					return nil, p.errorf("unsupported value for directive argument %s", argName)
+				}
+				d.Args = append(d.Args, Arg{Name: argName, Value: p.tok.text})
+				if err := p.next(); err != nil {
+					return nil, err
+				}
+			}
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		directives = append(directives, d)
+	}
+	return directives, nil
+}
+
+// skips a definition that isn't an object type, up to the end of its body (if any)
+func (p *parser) skipDefinition() error {
+	line := p.tok.line
+	continued := false // the previous token continues the definition on the next line e.g. `union U = A |`
+	for p.tok.kind != tokEOF {
+		if p.is("{") {
+			return p.skipBalanced("{", "}")
+		}
+		continued = p.is("|") || p.is("=") || p.is("&")
+		if err := p.next(); err != nil {
+			return err
+		}
+		if p.tok.line != line && p.tok.kind == tokName && !continued {
+			// definitions without a body (scalar, union) end at the line break
+			return nil
+		}
+		line = p.tok.line
+	}
+	return nil
+}
+
+func (p *parser) skipBalanced(left string, right string) error {
+	depth := 0
+	for {
+		switch {
+		case p.tok.kind == tokEOF:
+			return p.errorf("expected %q", right)
+		case p.is(left):
+			depth++
+		case p.is(right):
+			depth--
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+		if depth == 0 {
+			return nil
+		}
+	}
+}
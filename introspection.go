@@ -0,0 +1,267 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+// returned (wrapped) by ValidateSchema when the endpoint's schema is missing models or fields of the built-in models
+var ErrSchemaMismatch = errors.New("schema mismatch")
+
+const introspectionQuery string = `query introspection {
+	__schema {
+		types {
+			name
+			kind
+			fields {
+				name
+				type {
+					kind
+					name
+					ofType {
+						kind
+						name
+						ofType {
+							kind
+							name
+							ofType {
+								kind
+								name
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`
+
+// object types of a subgraph's schema, loaded with introspection (see Client.LoadSchema)
+type Schema struct {
+	Types  map[string]*SchemaType // entity types keyed by name e.g. "Pool"
+	models map[string]modelFields // field tables of the entity types, keyed by model name e.g. "pool"
+}
+
+// an entity type of an introspected schema
+type SchemaType struct {
+	Name   string
+	Fields []SchemaField
+}
+
+// a field of an entity type
+type SchemaField struct {
+	Name  string
+	Type  string // graphql type e.g. "[Swap!]!"
+	Named string // named type, unwrapping lists and non-null e.g. "Swap"
+	Kind  string // kind of the named type e.g. "SCALAR", "ENUM" or "OBJECT"
+	List  bool
+}
+
+type introspectionTypeRef struct {
+	Kind   string                `json:"kind"`
+	Name   string                `json:"name"`
+	OfType *introspectionTypeRef `json:"ofType"`
+}
+
+type introspectionResponse struct {
+	Schema struct {
+		Types []struct {
+			Name   string `json:"name"`
+			Kind   string `json:"kind"`
+			Fields []struct {
+				Name string               `json:"name"`
+				Type introspectionTypeRef `json:"type"`
+			} `json:"fields"`
+		} `json:"types"`
+	} `json:"__schema" mapstructure:"__schema"`
+}
+
+// LoadSchema queries the endpoint's schema with introspection. the schema can be used to validate queries with
+// RequestOptions.Schema, or compared to the built-in models with ValidateSchema.
+func (c *Client) LoadSchema(ctx context.Context) (*Schema, error) {
+	var raw map[string]any
+	if err := c.run(ctx, graphql.NewRequest(introspectionQuery), cacheLatest, &raw); err != nil {
+		return nil, err
+	}
+	var resp introspectionResponse
+	if err := decodeResponse(raw, &resp); err != nil {
+		return nil, err
+	}
+	if len(resp.Schema.Types) == 0 {
+		return nil, errors.New("schema error: introspection returned no types")
+	}
+
+	schema := &Schema{Types: map[string]*SchemaType{}}
+	for _, t := range resp.Schema.Types {
+		if !isEntityType(t.Name, t.Kind) {
+			continue
+		}
+		st := &SchemaType{Name: t.Name}
+		for _, f := range t.Fields {
+			st.Fields = append(st.Fields, newSchemaField(f.Name, f.Type))
+		}
+		schema.Types[t.Name] = st
+	}
+	schema.models = schema.modelFields()
+	return schema, nil
+}
+
+// reports whether an introspected type is an entity, rather than a root type (Query, Subscription) or a type added
+// by graph-node (_Meta_, _Block_, __Schema...)
+func isEntityType(name string, kind string) bool {
+	return kind == "OBJECT" && name != "Query" && name != "Subscription" && !strings.HasPrefix(name, "_")
+}
+
+func newSchemaField(name string, ref introspectionTypeRef) SchemaField {
+	field := SchemaField{Name: name, Type: typeRefString(&ref)}
+	for t := &ref; t != nil; t = t.OfType {
+		switch t.Kind {
+		case "LIST":
+			field.List = true
+		case "NON_NULL":
+		default:
+			field.Named = t.Name
+			field.Kind = t.Kind
+		}
+	}
+	return field
+}
+
+// renders a type reference as written in the schema e.g. "[Swap!]!"
+func typeRefString(t *introspectionTypeRef) string {
+	if t == nil {
+		return ""
+	}
+	switch t.Kind {
+	case "NON_NULL":
+		return typeRefString(t.OfType) + "!"
+	case "LIST":
+		return "[" + typeRefString(t.OfType) + "]"
+	}
+	return t.Name
+}
+
+// builds the field tables of the schema's entity types. as in the generated models, lists of entities are derived
+// fields.
+func (s *Schema) modelFields() map[string]modelFields {
+	models := map[string]modelFields{}
+	for _, t := range s.Types {
+		name := strings.ToLower(t.Name[:1]) + t.Name[1:]
+		model := modelFields{name: name, models: models}
+		for _, f := range t.Fields {
+			ref := ""
+			if _, ok := s.Types[f.Named]; ok {
+				ref = strings.ToLower(f.Named[:1]) + f.Named[1:]
+			}
+			switch {
+			case ref == "":
+				model.direct = append(model.direct, f.Name)
+			case !f.List:
+				if model.reference == nil {
+					model.reference = map[string]string{}
+				}
+				model.reference[f.Name] = ref
+			default:
+				if model.derived == nil {
+					model.derived = map[string]string{}
+				}
+				model.derived[f.Name] = ref
+			}
+		}
+		models[name] = model
+	}
+	return models
+}
+
+// returns the field table of a model (e.g. "pool") in the schema
+func (s *Schema) model(name string) (modelFields, error) {
+	model, ok := s.models[name]
+	if !ok {
+		return modelFields{}, fmt.Errorf("request options error: model %s not found in opts.Schema", name)
+	}
+	return model, nil
+}
+
+// differences between an endpoint's schema and the built-in models
+type SchemaDiff struct {
+	MissingModels []string // built-in models the endpoint doesn't have e.g. "positionSnapshot"
+	MissingFields []string // fields of the built-in models the endpoint doesn't have e.g. "pool.feeGrowthGlobal0X128"
+	ChangedFields []string // fields whose kind or referenced model differs e.g. "pool.token0 (built-in: reference to token, endpoint: direct)"
+	ExtraFields   []string // fields the endpoint has that the built-in models don't e.g. "token.newField"
+	ExtraModels   []string // entity models the endpoint has that aren't built in
+}
+
+// Compatible reports whether every built-in model and field exists on the endpoint unchanged. extra fields and
+// models are compatible.
+func (d *SchemaDiff) Compatible() bool {
+	return len(d.MissingModels) == 0 && len(d.MissingFields) == 0 && len(d.ChangedFields) == 0
+}
+
+// ValidateSchema compares the endpoint's schema (loaded with introspection) to the built-in models. the diff is always
+// returned when the schema loads, along with an error wrapping ErrSchemaMismatch if it isn't Compatible.
+func (c *Client) ValidateSchema(ctx context.Context) (*SchemaDiff, error) {
+	schema, err := c.LoadSchema(ctx)
+	if err != nil {
+		return nil, err
+	}
+	diff := diffSchema(modelMap, schema.models)
+	if !diff.Compatible() {
+		return diff, fmt.Errorf("%w: %d missing models, %d missing fields, %d changed fields", ErrSchemaMismatch, len(diff.MissingModels), len(diff.MissingFields), len(diff.ChangedFields))
+	}
+	return diff, nil
+}
+
+func diffSchema(builtin map[string]modelFields, endpoint map[string]modelFields) *SchemaDiff {
+	diff := &SchemaDiff{}
+	for _, name := range slices.Sorted(maps.Keys(builtin)) {
+		want := builtin[name]
+		got, ok := endpoint[name]
+		if !ok {
+			diff.MissingModels = append(diff.MissingModels, name)
+			continue
+		}
+		wantKinds := fieldKinds(want)
+		gotKinds := fieldKinds(got)
+		for _, field := range slices.Sorted(maps.Keys(wantKinds)) {
+			gotKind, ok := gotKinds[field]
+			switch {
+			case !ok:
+				diff.MissingFields = append(diff.MissingFields, name+"."+field)
+			case gotKind != wantKinds[field]:
+				diff.ChangedFields = append(diff.ChangedFields, fmt.Sprintf("%s.%s (built-in: %s, endpoint: %s)", name, field, wantKinds[field], gotKind))
+			}
+		}
+		for _, field := range slices.Sorted(maps.Keys(gotKinds)) {
+			if _, ok := wantKinds[field]; !ok {
+				diff.ExtraFields = append(diff.ExtraFields, name+"."+field)
+			}
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(endpoint)) {
+		if _, ok := builtin[name]; !ok {
+			diff.ExtraModels = append(diff.ExtraModels, name)
+		}
+	}
+	return diff
+}
+
+// describes each field of a model e.g. "direct", "reference to token" or "derived from swap"
+func fieldKinds(model modelFields) map[string]string {
+	kinds := map[string]string{}
+	for _, field := range model.direct {
+		kinds[field] = "direct"
+	}
+	for field, ref := range model.reference {
+		kinds[field] = "reference to " + ref
+	}
+	for field, ref := range model.derived {
+		kinds[field] = "derived from " + ref
+	}
+	return kinds
+}
@@ -0,0 +1,216 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type testTypeRef struct {
+	Kind   string       `json:"kind"`
+	Name   string       `json:"name,omitempty"`
+	OfType *testTypeRef `json:"ofType,omitempty"`
+}
+
+type testField struct {
+	Name string      `json:"name"`
+	Type testTypeRef `json:"type"`
+}
+
+type testType struct {
+	Name   string      `json:"name"`
+	Kind   string      `json:"kind"`
+	Fields []testField `json:"fields"`
+}
+
+func nonNull(t testTypeRef) testTypeRef {
+	return testTypeRef{Kind: "NON_NULL", OfType: &t}
+}
+
+func upperFirst(s string) string {
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// builds the introspected types of the built-in models, changed by mutate
+func introspectionTypes(mutate func(types map[string]*testType)) map[string]*testType {
+	types := map[string]*testType{
+		"Query":  {Name: "Query", Kind: "OBJECT", Fields: []testField{{Name: "pool", Type: testTypeRef{Kind: "OBJECT", Name: "Pool"}}}},
+		"_Meta_": {Name: "_Meta_", Kind: "OBJECT", Fields: []testField{{Name: "deployment", Type: nonNull(testTypeRef{Kind: "SCALAR", Name: "String"})}}},
+		"String": {Name: "String", Kind: "SCALAR"},
+	}
+	for name, model := range modelMap {
+		t := &testType{Name: upperFirst(name), Kind: "OBJECT"}
+		for _, field := range model.direct {
+			t.Fields = append(t.Fields, testField{Name: field, Type: nonNull(testTypeRef{Kind: "SCALAR", Name: "String"})})
+		}
+		for field, ref := range model.reference {
+			t.Fields = append(t.Fields, testField{Name: field, Type: nonNull(testTypeRef{Kind: "OBJECT", Name: upperFirst(ref)})})
+		}
+		for field, ref := range model.derived {
+			list := testTypeRef{Kind: "LIST", OfType: &testTypeRef{Kind: "NON_NULL", OfType: &testTypeRef{Kind: "OBJECT", Name: upperFirst(ref)}}}
+			t.Fields = append(t.Fields, testField{Name: field, Type: nonNull(list)})
+		}
+		types[t.Name] = t
+	}
+	if mutate != nil {
+		mutate(types)
+	}
+	return types
+}
+
+func removeField(t *testType, name string) {
+	for i, f := range t.Fields {
+		if f.Name == name {
+			t.Fields = append(t.Fields[:i], t.Fields[i+1:]...)
+			return
+		}
+	}
+}
+
+func getIntrospectionServer(t *testing.T, mutate func(types map[string]*testType)) *httptest.Server {
+	var types []*testType
+	for _, t := range introspectionTypes(mutate) {
+		types = append(types, t)
+	}
+	body, err := json.Marshal(map[string]any{"data": map[string]any{"__schema": map[string]any{"types": types}}})
+	assert.Nil(t, err)
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write(body)
+	}))
+}
+
+func TestLoadSchema(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getIntrospectionServer(t, nil)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		schema, err := client.LoadSchema(context.Background())
+		assert.Nil(t, err)
+		assert.Len(t, schema.Types, len(modelMap))
+		assert.NotContains(t, schema.Types, "Query")
+		assert.NotContains(t, schema.Types, "_Meta_")
+
+		var swaps SchemaField
+		for _, f := range schema.Types["Pool"].Fields {
+			if f.Name == "swaps" {
+				swaps = f
+			}
+		}
+		assert.Equal(t, SchemaField{Name: "swaps", Type: "[Swap!]!", Named: "Swap", Kind: "OBJECT", List: true}, swaps)
+	})
+
+	t.Run("when introspection returns no types", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"data":{"__schema":{"types":[]}}}`))
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		_, err := client.LoadSchema(context.Background())
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "pool")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		_, err := client.LoadSchema(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestValidateSchema(t *testing.T) {
+	t.Run("when the schema matches the built-in models", func(t *testing.T) {
+		server := getIntrospectionServer(t, nil)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		diff, err := client.ValidateSchema(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, diff.Compatible())
+		assert.Empty(t, diff.ExtraFields)
+		assert.Empty(t, diff.ExtraModels)
+	})
+
+	t.Run("when the schema has extra fields and models", func(t *testing.T) {
+		server := getIntrospectionServer(t, func(types map[string]*testType) {
+			types["Token"].Fields = append(types["Token"].Fields, testField{Name: "newField", Type: testTypeRef{Kind: "SCALAR", Name: "BigInt"}})
+			types["Vault"] = &testType{Name: "Vault", Kind: "OBJECT", Fields: []testField{{Name: "id", Type: nonNull(testTypeRef{Kind: "SCALAR", Name: "ID"})}}}
+		})
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		diff, err := client.ValidateSchema(context.Background())
+		assert.Nil(t, err)
+		assert.True(t, diff.Compatible())
+		assert.Equal(t, []string{"token.newField"}, diff.ExtraFields)
+		assert.Equal(t, []string{"vault"}, diff.ExtraModels)
+	})
+
+	t.Run("when the schema is missing models and fields", func(t *testing.T) {
+		server := getIntrospectionServer(t, func(types map[string]*testType) {
+			delete(types, "PositionSnapshot")
+			removeField(types["Pool"], "feeGrowthGlobal0X128")
+			removeField(types["Swap"], "pool")
+			types["Swap"].Fields = append(types["Swap"].Fields, testField{Name: "pool", Type: testTypeRef{Kind: "SCALAR", Name: "String"}})
+		})
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+		diff, err := client.ValidateSchema(context.Background())
+		assert.True(t, errors.Is(err, ErrSchemaMismatch))
+		assert.False(t, diff.Compatible())
+		assert.Equal(t, []string{"positionSnapshot"}, diff.MissingModels)
+		assert.Equal(t, []string{"pool.feeGrowthGlobal0X128"}, diff.MissingFields)
+		assert.Equal(t, []string{"swap.pool (built-in: reference to pool, endpoint: direct)"}, diff.ChangedFields)
+	})
+}
+
+func TestSchemaRequestOption(t *testing.T) {
+	server := getIntrospectionServer(t, func(types map[string]*testType) {
+		delete(types, "PositionSnapshot")
+		removeField(types["Pool"], "feeGrowthGlobal0X128")
+		types["Pool"].Fields = append(types["Pool"].Fields, testField{Name: "hooks", Type: testTypeRef{Kind: "SCALAR", Name: "Bytes"}})
+	})
+	defer server.Close()
+
+	schema, err := NewClient(server.URL, nil).LoadSchema(context.Background())
+	assert.Nil(t, err)
+
+	t.Run("when fields exist in the schema", func(t *testing.T) {
+		req, err := constructByIdQuery("test", PoolFields, &RequestOptions{IncludeFields: []string{"hooks", "token0.symbol", "swaps.id"}, Schema: schema})
+		assert.Nil(t, err)
+		assert.Contains(t, req.Query(), "hooks")
+		assert.Contains(t, req.Query(), "swaps")
+	})
+
+	t.Run("when all fields are included", func(t *testing.T) {
+		req, err := constructListQuery(PoolFields, &RequestOptions{IncludeFields: []string{"*"}, Schema: schema})
+		assert.Nil(t, err)
+		assert.Contains(t, req.Query(), "hooks")
+		assert.NotContains(t, req.Query(), "feeGrowthGlobal0X128")
+	})
+
+	t.Run("when a field is missing from the schema", func(t *testing.T) {
+		_, err := constructByIdQuery("test", PoolFields, &RequestOptions{IncludeFields: []string{"feeGrowthGlobal0X128"}, Schema: schema})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when a filter field is missing from the schema", func(t *testing.T) {
+		_, err := constructListQuery(PoolFields, &RequestOptions{IncludeFields: []string{"id"}, Where: Where{"feeGrowthGlobal0X128_gt": "0"}, Schema: schema})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when the model is missing from the schema", func(t *testing.T) {
+		_, err := constructListQuery(PositionSnapshotFields, &RequestOptions{IncludeFields: []string{"id"}, Schema: schema})
+		assert.NotNil(t, err)
+	})
+}
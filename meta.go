@@ -0,0 +1,86 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+// returned (wrapped) when a request's MaxBlockLag or MaxAge guard fails
+var ErrStaleSubgraph = errors.New("stale subgraph")
+
+const metaQuery string = "query meta {\n	_meta {\n		block {\n			number\n			hash\n			timestamp\n		}\n		deployment\n		hasIndexingErrors\n	}\n}"
+
+// indexing status of the subgraph
+type Meta struct {
+	Block             MetaBlock `json:"block"`
+	Deployment        string    `json:"deployment"`        // IPFS hash of the subgraph deployment
+	HasIndexingErrors bool      `json:"hasIndexingErrors"` // true if the subgraph encountered indexing errors at some past block
+}
+
+// latest block indexed by the subgraph
+type MetaBlock struct {
+	Number    int    `json:"number"`
+	Hash      string `json:"hash"`
+	Timestamp int    `json:"timestamp"` // unix timestamp of the block. 0 if the graph node doesn't report it.
+}
+
+type MetaResponse struct {
+	Meta Meta `json:"_meta" mapstructure:"_meta"`
+}
+
+// Time returns the block timestamp as a time.Time (the zero time if it isn't reported)
+func (b MetaBlock) Time() time.Time {
+	if b.Timestamp == 0 {
+		return time.Time{}
+	}
+	return time.Unix(int64(b.Timestamp), 0)
+}
+
+// GetMeta queries the subgraph's indexing status: the latest indexed block, the deployment and whether it has indexing errors
+func (c *Client) GetMeta(ctx context.Context) (*MetaResponse, error) {
+	var resp interface{}
+	if err := c.run(ctx, graphql.NewRequest(metaQuery), noCache, &resp); err != nil {
+		return nil, err
+	}
+	var meta MetaResponse
+	if err := decodeResponse(resp, &meta); err != nil {
+		return nil, err
+	}
+	return &meta, nil
+}
+
+// reports whether opts asks for the subgraph's indexing status to be checked
+func hasStalenessGuard(opts *RequestOptions) bool {
+	return opts != nil && (opts.MaxBlockLag > 0 || opts.MaxAge > 0)
+}
+
+// checks meta against the MaxBlockLag and MaxAge guards in opts, returning an error wrapping ErrStaleSubgraph if either fails
+func (c *Client) checkStaleness(ctx context.Context, meta Meta, opts *RequestOptions) error {
+	if opts.MaxBlockLag > 0 {
+		if c.chainHead == nil {
+			return errors.New("request options error: MaxBlockLag requires ClientOptions.ChainHead")
+		}
+		head, err := c.chainHead(ctx)
+		if err != nil {
+			return fmt.Errorf("chain head error: %w", err)
+		}
+		if lag := head - meta.Block.Number; lag > opts.MaxBlockLag {
+			return fmt.Errorf("%w: indexed block %d is %d blocks behind the chain head %d (MaxBlockLag %d)", ErrStaleSubgraph, meta.Block.Number, lag, head, opts.MaxBlockLag)
+		}
+	}
+
+	if opts.MaxAge > 0 {
+		if meta.Block.Timestamp == 0 {
+			return fmt.Errorf("%w: the subgraph didn't report the timestamp of indexed block %d (MaxAge %s)", ErrStaleSubgraph, meta.Block.Number, opts.MaxAge)
+		}
+		if age := time.Since(meta.Block.Time()); age > opts.MaxAge {
+			return fmt.Errorf("%w: indexed block %d is %s old (MaxAge %s)", ErrStaleSubgraph, meta.Block.Number, age.Truncate(time.Second), opts.MaxAge)
+		}
+	}
+
+	return nil
+}
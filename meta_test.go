@@ -0,0 +1,138 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetMeta(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Unix(1700000000, 0))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.GetMeta(context.Background())
+		assert.Nil(t, err)
+		assert.Equal(t, 100, resp.Meta.Block.Number)
+		assert.Equal(t, "0xabc", resp.Meta.Block.Hash)
+		assert.Equal(t, time.Unix(1700000000, 0), resp.Meta.Block.Time())
+		assert.Equal(t, "Qm123", resp.Meta.Deployment)
+		assert.True(t, resp.Meta.HasIndexingErrors)
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "factory")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		_, err := client.GetMeta(context.Background())
+		assert.NotNil(t, err)
+	})
+}
+
+func TestStalenessGuard(t *testing.T) {
+	chainHead := func(head int) func(context.Context) (int, error) {
+		return func(context.Context) (int, error) { return head, nil }
+	}
+
+	t.Run("when the subgraph is within MaxBlockLag", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Now())
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{ChainHead: chainHead(105)})
+
+		resp, err := client.ListFactories(context.Background(), &RequestOptions{MaxBlockLag: 5})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Factories, 1)
+	})
+
+	t.Run("when the subgraph exceeds MaxBlockLag", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Now())
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{ChainHead: chainHead(110)})
+
+		_, err := client.GetFactoryById(context.Background(), "test", &RequestOptions{MaxBlockLag: 5})
+		assert.True(t, errors.Is(err, ErrStaleSubgraph))
+		assert.Contains(t, err.Error(), "10 blocks behind the chain head 110")
+	})
+
+	t.Run("when ChainHead is missing", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Now())
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		_, err := client.ListFactories(context.Background(), &RequestOptions{MaxBlockLag: 5})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "MaxBlockLag requires ClientOptions.ChainHead")
+	})
+
+	t.Run("when the indexed block is older than MaxAge", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Now().Add(-time.Hour))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		_, err := client.ListFactories(context.Background(), &RequestOptions{MaxAge: time.Minute})
+		assert.True(t, errors.Is(err, ErrStaleSubgraph))
+
+		resp, err := client.ListFactories(context.Background(), &RequestOptions{MaxAge: 2 * time.Hour})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Factories, 1)
+	})
+
+	t.Run("when paginating", func(t *testing.T) {
+		server := getMetaTestServer(t, 100, time.Now().Add(-time.Hour))
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		_, _, err := client.ListAllFactories(context.Background(), &RequestOptions{MaxAge: time.Minute}, nil)
+		assert.True(t, errors.Is(err, ErrStaleSubgraph))
+	})
+
+	t.Run("when guards are negative", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		_, err := client.ListFactories(context.Background(), &RequestOptions{MaxAge: -time.Second})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+}
+
+// serves _meta at the given block, selected alone or alongside factories
+func getMetaTestServer(t *testing.T, block int, timestamp time.Time) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query string
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		data := map[string]any{}
+		if strings.Contains(body.Query, "_meta") {
+			data["_meta"] = map[string]any{
+				"block":             map[string]any{"number": block, "hash": "0xabc", "timestamp": timestamp.Unix()},
+				"deployment":        "Qm123",
+				"hasIndexingErrors": true,
+			}
+		}
+		if strings.Contains(body.Query, "factory(") {
+			data["factory"] = map[string]any{"id": "test"}
+		}
+		if strings.Contains(body.Query, "factories(") {
+			data["factories"] = []any{map[string]any{"id": "test"}}
+		}
+		json.NewEncoder(w).Encode(map[string]any{"data": data})
+	}))
+}
@@ -0,0 +1,41 @@
+package unigraphclient
+
+// fields of a model, used to construct and validate queries. the tables for each model are generated in models.go.
+type modelFields struct {
+	name      string                 // the name of the model
+	direct    []string               // basic scalar types directly on the model e.g. Int, String
+	reference map[string]string      // fields that reference other models e.g. Token, Pool
+	derived   map[string]string      // list fields of other models (@derivedFrom or stored lists) e.g. [Swap!]!. only selected explicitly.
+	models    map[string]modelFields // models that references resolve to. nil means modelMap (set for models of an introspected Schema).
+}
+
+// returns the model with the given name from the same set of models as m
+func (m modelFields) lookup(name string) (modelFields, bool) {
+	models := m.models
+	if models == nil {
+		models = modelMap
+	}
+	model, ok := models[name]
+	return model, ok
+}
+
+// returns the model referenced by a reference or derived field
+func (m modelFields) relation(field string) (string, bool) {
+	if ref, ok := m.reference[field]; ok {
+		return ref, true
+	}
+	ref, ok := m.derived[field]
+	return ref, ok
+}
+
+// returns the reference and derived fields of the model
+func (m modelFields) relations() map[string]string {
+	relations := make(map[string]string, len(m.reference)+len(m.derived))
+	for k, v := range m.reference {
+		relations[k] = v
+	}
+	for k, v := range m.derived {
+		relations[k] = v
+	}
+	return relations
+}
@@ -1,13 +1,10 @@
+// Code generated by modelgen from schema.graphql. DO NOT EDIT.
+
 package unigraphclient
 
-// graphql types transcribed from https://github.com/Uniswap/v3-subgraph/blob/main/schema.graphql
+import "github.com/emersonmacro/go-uniswap-subgraph-client/typed"
 
-type modelFields struct {
-	name      string            // the name of the model
-	direct    []string          // basic scalar types directly on the model e.g. Int, String
-	reference map[string]string // fields that reference other models e.g. Token, Pool
-	// TODO: add support for derived fields
-}
+// graphql types of the uniswap v3 subgraph (https://github.com/Uniswap/v3-subgraph/blob/main/schema.graphql)
 
 var modelMap map[string]modelFields = map[string]modelFields{
 	"factory":          FactoryFields,
@@ -32,6 +29,101 @@ var modelMap map[string]modelFields = map[string]modelFields{
 	"tokenHourData":    TokenHourDataFields,
 }
 
+// plural names of the models, used as the root fields of List queries
+var modelPlurals map[string]string = map[string]string{
+	"factory":          "factories",
+	"pool":             "pools",
+	"token":            "tokens",
+	"bundle":           "bundles",
+	"tick":             "ticks",
+	"position":         "positions",
+	"positionSnapshot": "positionSnapshots",
+	"transaction":      "transactions",
+	"mint":             "mints",
+	"burn":             "burns",
+	"swap":             "swaps",
+	"collect":          "collects",
+	"flash":            "flashes",
+	"uniswapDayData":   "uniswapDayDatas",
+	"poolDayData":      "poolDayDatas",
+	"poolHourData":     "poolHourDatas",
+	"tickHourData":     "tickHourDatas",
+	"tickDayData":      "tickDayDatas",
+	"tokenDayData":     "tokenDayDatas",
+	"tokenHourData":    "tokenHourDatas",
+}
+
+// type constraint for Get and GetList: the models defined in models.go and their variants in the typed package
+type Model interface {
+	Factory | typed.Factory |
+		Pool | typed.Pool |
+		Token | typed.Token |
+		Bundle | typed.Bundle |
+		Tick | typed.Tick |
+		Position | typed.Position |
+		PositionSnapshot | typed.PositionSnapshot |
+		Transaction | typed.Transaction |
+		Mint | typed.Mint |
+		Burn | typed.Burn |
+		Swap | typed.Swap |
+		Collect | typed.Collect |
+		Flash | typed.Flash |
+		UniswapDayData | typed.UniswapDayData |
+		PoolDayData | typed.PoolDayData |
+		PoolHourData | typed.PoolHourData |
+		TickHourData | typed.TickHourData |
+		TickDayData | typed.TickDayData |
+		TokenDayData | typed.TokenDayData |
+		TokenHourData | typed.TokenHourData
+}
+
+// type constraint for the responses of the models, see Response
+type modelResponse interface {
+	FactoryResponse | ListFactoriesResponse |
+		PoolResponse | ListPoolsResponse |
+		TokenResponse | ListTokensResponse |
+		BundleResponse | ListBundlesResponse |
+		TickResponse | ListTicksResponse |
+		PositionResponse | ListPositionsResponse |
+		PositionSnapshotResponse | ListPositionSnapshotsResponse |
+		TransactionResponse | ListTransactionsResponse |
+		MintResponse | ListMintsResponse |
+		BurnResponse | ListBurnsResponse |
+		SwapResponse | ListSwapsResponse |
+		CollectResponse | ListCollectsResponse |
+		FlashResponse | ListFlashesResponse |
+		UniswapDayDataResponse | ListUniswapDayDatasResponse |
+		PoolDayDataResponse | ListPoolDayDatasResponse |
+		PoolHourDataResponse | ListPoolHourDatasResponse |
+		TickHourDataResponse | ListTickHourDatasResponse |
+		TickDayDataResponse | ListTickDayDatasResponse |
+		TokenDayDataResponse | ListTokenDayDatasResponse |
+		TokenHourDataResponse | ListTokenHourDatasResponse
+}
+
+func init() {
+	registerModel[Factory, typed.Factory]("factory")
+	registerModel[Pool, typed.Pool]("pool")
+	registerModel[Token, typed.Token]("token")
+	registerModel[Bundle, typed.Bundle]("bundle")
+	registerModel[Tick, typed.Tick]("tick")
+	registerModel[Position, typed.Position]("position")
+	registerModel[PositionSnapshot, typed.PositionSnapshot]("positionSnapshot")
+	registerModel[Transaction, typed.Transaction]("transaction")
+	registerModel[Mint, typed.Mint]("mint")
+	registerModel[Burn, typed.Burn]("burn")
+	registerModel[Swap, typed.Swap]("swap")
+	registerModel[Collect, typed.Collect]("collect")
+	registerModel[Flash, typed.Flash]("flash")
+	registerModel[UniswapDayData, typed.UniswapDayData]("uniswapDayData")
+	registerModel[PoolDayData, typed.PoolDayData]("poolDayData")
+	registerModel[PoolHourData, typed.PoolHourData]("poolHourData")
+	registerModel[TickHourData, typed.TickHourData]("tickHourData")
+	registerModel[TickDayData, typed.TickDayData]("tickDayData")
+	registerModel[TokenDayData, typed.TokenDayData]("tokenDayData")
+	registerModel[TokenHourData, typed.TokenHourData]("tokenHourData")
+}
+
 type FactoryResponse struct {
 	Factory Factory
 }
@@ -84,35 +176,42 @@ type ListPoolsResponse struct {
 }
 
 type Pool struct {
-	ID                           string `json:"id"`
-	CreatedAtTimestamp           string `json:"createdAtTimestamp"`
-	CreatedAtBlockNumber         string `json:"createdAtBlockNumber"`
-	Token0                       Token  `json:"token0"`
-	Token1                       Token  `json:"token1"`
-	FeeTier                      string `json:"feeTier"`
-	Liquidity                    string `json:"liquidity"`
-	SqrtPrice                    string `json:"sqrtPrice"`
-	FeeGrowthGlobal0X128         string `json:"feeGrowthGlobal0X128"`
-	FeeGrowthGlobal1X128         string `json:"feeGrowthGlobal1X128"`
-	Token0Price                  string `json:"token0Price"`
-	Token1Price                  string `json:"token1Price"`
-	Tick                         string `json:"tick"`
-	ObservationIndex             string `json:"observationIndex"`
-	VolumeToken0                 string `json:"volumeToken0"`
-	VolumeToken1                 string `json:"volumeToken1"`
-	VolumeUSD                    string `json:"volumeUSD"`
-	UntrackedVolumeUSD           string `json:"untrackedVolumeUSD"`
-	FeesUSD                      string `json:"feesUSD"`
-	TxCount                      string `json:"txCount"`
-	CollectedFeesToken0          string `json:"collectedFeesToken0"`
-	CollectedFeesToken1          string `json:"collectedFeesToken1"`
-	CollectedFeesUSD             string `json:"collectedFeesUSD"`
-	TotalValueLockedToken0       string `json:"totalValueLockedToken0"`
-	TotalValueLockedToken1       string `json:"totalValueLockedToken1"`
-	TotalValueLockedETH          string `json:"totalValueLockedETH"`
-	TotalValueLockedUSD          string `json:"totalValueLockedUSD"`
-	TotalValueLockedUSDUntracked string `json:"totalValueLockedUSDUntracked"`
-	LiquidityProviderCount       string `json:"liquidityProviderCount"`
+	ID                           string         `json:"id"`
+	CreatedAtTimestamp           string         `json:"createdAtTimestamp"`
+	CreatedAtBlockNumber         string         `json:"createdAtBlockNumber"`
+	Token0                       Token          `json:"token0"`
+	Token1                       Token          `json:"token1"`
+	FeeTier                      string         `json:"feeTier"`
+	Liquidity                    string         `json:"liquidity"`
+	SqrtPrice                    string         `json:"sqrtPrice"`
+	FeeGrowthGlobal0X128         string         `json:"feeGrowthGlobal0X128"`
+	FeeGrowthGlobal1X128         string         `json:"feeGrowthGlobal1X128"`
+	Token0Price                  string         `json:"token0Price"`
+	Token1Price                  string         `json:"token1Price"`
+	Tick                         string         `json:"tick"`
+	ObservationIndex             string         `json:"observationIndex"`
+	VolumeToken0                 string         `json:"volumeToken0"`
+	VolumeToken1                 string         `json:"volumeToken1"`
+	VolumeUSD                    string         `json:"volumeUSD"`
+	UntrackedVolumeUSD           string         `json:"untrackedVolumeUSD"`
+	FeesUSD                      string         `json:"feesUSD"`
+	TxCount                      string         `json:"txCount"`
+	CollectedFeesToken0          string         `json:"collectedFeesToken0"`
+	CollectedFeesToken1          string         `json:"collectedFeesToken1"`
+	CollectedFeesUSD             string         `json:"collectedFeesUSD"`
+	TotalValueLockedToken0       string         `json:"totalValueLockedToken0"`
+	TotalValueLockedToken1       string         `json:"totalValueLockedToken1"`
+	TotalValueLockedETH          string         `json:"totalValueLockedETH"`
+	TotalValueLockedUSD          string         `json:"totalValueLockedUSD"`
+	TotalValueLockedUSDUntracked string         `json:"totalValueLockedUSDUntracked"`
+	LiquidityProviderCount       string         `json:"liquidityProviderCount"`
+	PoolHourData                 []PoolHourData `json:"poolHourData,omitempty"`
+	PoolDayData                  []PoolDayData  `json:"poolDayData,omitempty"`
+	Mints                        []Mint         `json:"mints,omitempty"`
+	Burns                        []Burn         `json:"burns,omitempty"`
+	Swaps                        []Swap         `json:"swaps,omitempty"`
+	Collects                     []Collect      `json:"collects,omitempty"`
+	Ticks                        []Tick         `json:"ticks,omitempty"`
 }
 
 var PoolFields modelFields = modelFields{
@@ -150,6 +249,15 @@ var PoolFields modelFields = modelFields{
 		"token0": "token", // Token!
 		"token1": "token", // Token!
 	},
+	derived: map[string]string{
+		"poolHourData": "poolHourData", // [PoolHourData!]! @derivedFrom(field: "pool")
+		"poolDayData":  "poolDayData",  // [PoolDayData!]! @derivedFrom(field: "pool")
+		"mints":        "mint",         // [Mint!]! @derivedFrom(field: "pool")
+		"burns":        "burn",         // [Burn!]! @derivedFrom(field: "pool")
+		"swaps":        "swap",         // [Swap!]! @derivedFrom(field: "pool")
+		"collects":     "collect",      // [Collect!]! @derivedFrom(field: "pool")
+		"ticks":        "tick",         // [Tick!]! @derivedFrom(field: "pool")
+	},
 }
 
 type TokenResponse struct {
@@ -161,21 +269,23 @@ type ListTokensResponse struct {
 }
 
 type Token struct {
-	ID                           string `json:"id"`
-	Symbol                       string `json:"symbol"`
-	Name                         string `json:"name"`
-	Decimals                     string `json:"decimals"`
-	TotalSupply                  string `json:"totalSupply"`
-	Volume                       string `json:"volume"`
-	VolumeUSD                    string `json:"volumeUSD"`
-	UntrackedVolumeUSD           string `json:"untrackedVolumeUSD"`
-	FeesUSD                      string `json:"feesUSD"`
-	TxCount                      string `json:"txCount"`
-	PoolCount                    string `json:"poolCount"`
-	TotalValueLocked             string `json:"totalValueLocked"`
-	TotalValueLockedUSD          string `json:"totalValueLockedUSD"`
-	TotalValueLockedUSDUntracked string `json:"totalValueLockedUSDUntracked"`
-	DerivedETH                   string `json:"derivedETH"`
+	ID                           string         `json:"id"`
+	Symbol                       string         `json:"symbol"`
+	Name                         string         `json:"name"`
+	Decimals                     string         `json:"decimals"`
+	TotalSupply                  string         `json:"totalSupply"`
+	Volume                       string         `json:"volume"`
+	VolumeUSD                    string         `json:"volumeUSD"`
+	UntrackedVolumeUSD           string         `json:"untrackedVolumeUSD"`
+	FeesUSD                      string         `json:"feesUSD"`
+	TxCount                      string         `json:"txCount"`
+	PoolCount                    string         `json:"poolCount"`
+	TotalValueLocked             string         `json:"totalValueLocked"`
+	TotalValueLockedUSD          string         `json:"totalValueLockedUSD"`
+	TotalValueLockedUSDUntracked string         `json:"totalValueLockedUSDUntracked"`
+	DerivedETH                   string         `json:"derivedETH"`
+	WhitelistPools               []Pool         `json:"whitelistPools,omitempty"`
+	TokenDayData                 []TokenDayData `json:"tokenDayData,omitempty"`
 }
 
 var TokenFields modelFields = modelFields{
@@ -197,8 +307,9 @@ var TokenFields modelFields = modelFields{
 		"totalValueLockedUSDUntracked", // BigDecimal!
 		"derivedETH",                   // BigDecimal!
 	},
-	reference: map[string]string{
-		"whitelistPools": "pool", // [Pool!]!
+	derived: map[string]string{
+		"whitelistPools": "pool",         // [Pool!]!
+		"tokenDayData":   "tokenDayData", // [TokenDayData!]! @derivedFrom(field: "token")
 	},
 }
 
@@ -397,11 +508,16 @@ type ListTransactionsResponse struct {
 }
 
 type Transaction struct {
-	ID          string `json:"id"`
-	BlockNumber string `json:"blockNumber"`
-	Timestamp   string `json:"timestamp"`
-	GasUsed     string `json:"gasUsed"`
-	GasPrice    string `json:"gasPrice"`
+	ID          string    `json:"id"`
+	BlockNumber string    `json:"blockNumber"`
+	Timestamp   string    `json:"timestamp"`
+	GasUsed     string    `json:"gasUsed"`
+	GasPrice    string    `json:"gasPrice"`
+	Mints       []Mint    `json:"mints,omitempty"`
+	Burns       []Burn    `json:"burns,omitempty"`
+	Swaps       []Swap    `json:"swaps,omitempty"`
+	Flashed     []Flash   `json:"flashed,omitempty"`
+	Collects    []Collect `json:"collects,omitempty"`
 }
 
 var TransactionFields modelFields = modelFields{
@@ -413,6 +529,13 @@ var TransactionFields modelFields = modelFields{
 		"gasUsed",     // BigInt!
 		"gasPrice",    // BigInt!
 	},
+	derived: map[string]string{
+		"mints":    "mint",    // [Mint]! @derivedFrom(field: "transaction")
+		"burns":    "burn",    // [Burn]! @derivedFrom(field: "transaction")
+		"swaps":    "swap",    // [Swap]! @derivedFrom(field: "transaction")
+		"flashed":  "flash",   // [Flash]! @derivedFrom(field: "transaction")
+		"collects": "collect", // [Collect]! @derivedFrom(field: "transaction")
+	},
 }
 
 type MintResponse struct {
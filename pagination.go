@@ -0,0 +1,291 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+	"slices"
+)
+
+// pages through a List query using cursors on the OrderBy field (and id) instead of Skip, which the graph caps at 5000.
+// every page is pinned to the same block so the result set is consistent.
+type pager struct {
+	client *Client
+	model  modelFields
+	opts   RequestOptions
+
+	cursor       any      // OrderBy value of the last row returned
+	cursorIds    []string // ids of the rows returned with the cursor value, excluded from the next page
+	started      bool
+	done         bool
+	pagesFetched int
+	rowsFetched  int
+}
+
+func newPager(ctx context.Context, c *Client, model modelFields, opts *RequestOptions) (*pager, error) {
+	var pagerOpts RequestOptions
+	if opts != nil {
+		pagerOpts = *opts
+	}
+
+	if pagerOpts.Skip != 0 {
+		return nil, errors.New("request options error: Skip is not supported when paginating with cursors")
+	}
+	if err := validateRequestOpts(List, &pagerOpts); err != nil {
+		return nil, err
+	}
+	if pagerOpts.OrderBy != "id" && !validateField(model, pagerOpts.OrderBy) {
+		return nil, fmt.Errorf("request options error: OrderBy must be a direct field when paginating with cursors (%s)", pagerOpts.OrderBy)
+	}
+
+	// the cursor fields must be selected so they can be read back from each page
+	cursorFields := []string{"id", pagerOpts.OrderBy}
+	if slices.Contains(pagerOpts.IncludeFields, "*") {
+		pagerOpts.ExcludeFields = slices.DeleteFunc(slices.Clone(pagerOpts.ExcludeFields), func(field string) bool {
+			return slices.Contains(cursorFields, field)
+		})
+	} else {
+		fields := slices.Clone(pagerOpts.IncludeFields)
+		for _, field := range cursorFields {
+			if !slices.Contains(fields, field) {
+				fields = append(fields, field)
+			}
+		}
+		pagerOpts.IncludeFields = fields
+	}
+
+	latestBlock := 0
+	if hasStalenessGuard(&pagerOpts) {
+		// the guard is checked once against the block every page is pinned to, rather than on every page
+		resp, err := c.GetMeta(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.checkStaleness(ctx, resp.Meta, &pagerOpts); err != nil {
+			return nil, err
+		}
+		latestBlock = resp.Meta.Block.Number
+		pagerOpts.MaxBlockLag, pagerOpts.MaxAge = 0, 0
+	}
+
+	// pages are pinned to an exact block, so BlockNumberGte is resolved to the latest block once it has been checked
+	if pagerOpts.Block == 0 && pagerOpts.BlockHash == "" {
+		if latestBlock == 0 {
+			block, err := c.latestBlock(ctx)
+			if err != nil {
+				return nil, err
+			}
+			latestBlock = block
+		}
+		if latestBlock < pagerOpts.BlockNumberGte {
+			return nil, fmt.Errorf("pagination error: the subgraph has only indexed up to block %d (BlockNumberGte %d)", latestBlock, pagerOpts.BlockNumberGte)
+		}
+		pagerOpts.Block = latestBlock
+		pagerOpts.BlockNumberGte = 0
+	}
+
+	return &pager{
+		client: c,
+		model:  model,
+		opts:   pagerOpts,
+	}, nil
+}
+
+// fetches the next page of raw rows. returns nil once every page has been fetched.
+func (p *pager) next(ctx context.Context) ([]map[string]any, error) {
+	if p.done {
+		return nil, nil
+	}
+
+	pageOpts := p.opts
+	if p.started {
+		pageOpts.Where = p.cursorWhere()
+	}
+
+	req, err := constructListQuery(p.model, &pageOpts)
+	if err != nil {
+		return nil, err
+	}
+
+	var resp map[string]any
+	if err := p.client.run(ctx, req, requestCachePolicy(&pageOpts), &resp); err != nil {
+		return nil, err
+	}
+
+	rawRows, _ := resp[pluralizeModelName(p.model.name)].([]any)
+	rows := make([]map[string]any, 0, len(rawRows))
+	for _, rawRow := range rawRows {
+		row, ok := rawRow.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("pagination error: unexpected row in response (%v)", rawRow)
+		}
+		rows = append(rows, row)
+	}
+
+	p.started = true
+	p.pagesFetched++
+	p.rowsFetched += len(rows)
+	if len(rows) < pageOpts.First {
+		p.done = true
+	}
+	if err := p.advance(rows); err != nil {
+		return nil, err
+	}
+
+	return rows, nil
+}
+
+// moves the cursor to the last row of the page
+func (p *pager) advance(rows []map[string]any) error {
+	for _, row := range rows {
+		id, ok := row["id"].(string)
+		if !ok {
+			return errors.New("pagination error: row is missing an id")
+		}
+		value := row[p.opts.OrderBy]
+		if p.opts.OrderBy == "id" {
+			p.cursor = id
+			continue
+		}
+		if fmt.Sprint(value) != fmt.Sprint(p.cursor) {
+			p.cursor = value
+			p.cursorIds = nil
+		}
+		p.cursorIds = append(p.cursorIds, id)
+	}
+	return nil
+}
+
+// builds the where clause selecting the rows after the cursor, combined with the caller's where clause
+func (p *pager) cursorWhere() Where {
+	var cursorWhere Where
+	if p.opts.OrderBy == "id" {
+		if p.opts.OrderDir == "desc" {
+			cursorWhere = Lt("id", p.cursor)
+		} else {
+			cursorWhere = Gt("id", p.cursor)
+		}
+	} else {
+		if p.opts.OrderDir == "desc" {
+			cursorWhere = Lte(p.opts.OrderBy, p.cursor)
+		} else {
+			cursorWhere = Gte(p.opts.OrderBy, p.cursor)
+		}
+		if len(p.cursorIds) > 0 {
+			cursorWhere = Merge(cursorWhere, NotIn("id", p.cursorIds))
+		}
+	}
+
+	if len(p.opts.Where) == 0 {
+		return cursorWhere
+	}
+	// merging would put the cursor predicates next to a top-level `or` (rejected by graph-node) or overwrite the
+	// caller's own predicates on the cursor fields, so the two filters are always combined with `and`
+	return And(p.opts.Where, cursorWhere)
+}
+
+// streams every row of a List query, decoded into T
+func iterate[T any](ctx context.Context, c *Client, model modelFields, opts *RequestOptions) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		p, err := newPager(ctx, c, model, opts)
+		if err != nil {
+			yield(zero, err)
+			return
+		}
+		for {
+			rows, err := p.next(ctx)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, row := range rows {
+				var converted T
+				if err := decodeResponse(row, &converted); err != nil {
+					yield(zero, err)
+					return
+				}
+				if !yield(converted, nil) {
+					return
+				}
+			}
+			if p.done {
+				return
+			}
+		}
+	}
+}
+
+// collects every row of a List query into a single response, within the given limits
+func fetchAll[T Response](ctx context.Context, c *Client, model modelFields, opts *RequestOptions, limits *ListAllOptions, converted T) (*T, *PageSummary, error) {
+	rows, summary, err := collectAll(ctx, c, model, opts, limits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp := map[string]any{
+		pluralizeModelName(model.name): rows,
+	}
+	if err := decodeResponse(resp, &converted); err != nil {
+		return nil, nil, err
+	}
+	return &converted, summary, nil
+}
+
+// collects the raw rows of every page of a List query, within the given limits
+func collectAll(ctx context.Context, c *Client, model modelFields, opts *RequestOptions, limits *ListAllOptions) ([]any, *PageSummary, error) {
+	if limits == nil {
+		limits = &ListAllOptions{}
+	}
+	if limits.MaxRows < 0 || limits.MaxRequests < 0 {
+		return nil, nil, errors.New("request options error: MaxRows and MaxRequests must not be negative")
+	}
+
+	p, err := newPager(ctx, c, model, opts)
+	if err != nil {
+		return nil, nil, err
+	}
+	pageSize := p.opts.First
+
+	rows := []any{}
+	for !p.done {
+		if limits.MaxRequests > 0 && p.pagesFetched >= limits.MaxRequests {
+			break
+		}
+		if limits.MaxRows > 0 {
+			if p.rowsFetched >= limits.MaxRows {
+				break
+			}
+			p.opts.First = min(pageSize, limits.MaxRows-p.rowsFetched)
+		}
+		page, err := p.next(ctx)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, row := range page {
+			rows = append(rows, row)
+		}
+	}
+
+	summary := &PageSummary{
+		Pages:    p.pagesFetched,
+		Rows:     p.rowsFetched,
+		Block:    p.opts.Block,
+		Complete: p.done,
+	}
+	return rows, summary, nil
+}
+
+// queries the block number the subgraph has indexed up to
+func (c *Client) latestBlock(ctx context.Context) (int, error) {
+	resp, err := c.GetMeta(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Meta.Block.Number == 0 {
+		return 0, errors.New("pagination error: unable to determine the latest indexed block")
+	}
+
+	return resp.Meta.Block.Number, nil
+}
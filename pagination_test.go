@@ -0,0 +1,351 @@
+package unigraphclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIterSwaps(t *testing.T) {
+	t.Run("when paging by id", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		var ids []string
+		for swap, err := range client.IterSwaps(context.Background(), &RequestOptions{IncludeFields: []string{"amountUSD"}, First: 10}) {
+			assert.Nil(t, err)
+			ids = append(ids, swap.ID)
+		}
+
+		assert.Len(t, ids, 25)
+		assert.True(t, slices.IsSorted(ids))
+		assert.Len(t, *requests, 4) // _meta + 3 pages
+		for _, req := range (*requests)[1:] {
+			assert.Equal(t, float64(12345), req.block)
+		}
+		assert.Equal(t, "swap-0009", (*requests)[2].where["id_gt"])
+	})
+
+	t.Run("when paging by a non-unique field", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+			First:         10,
+			OrderBy:       "timestamp",
+			Block:         100,
+			Where:         Where{"amountUSD_gt": "0"},
+		}
+		var ids []string
+		for swap, err := range client.IterSwaps(context.Background(), opts) {
+			assert.Nil(t, err)
+			ids = append(ids, swap.ID)
+		}
+
+		assert.Len(t, ids, 25)
+		slices.Sort(ids)
+		assert.Len(t, slices.Compact(ids), 25)
+		assert.Len(t, *requests, 3) // block is pinned by opts, so no _meta request
+		and := (*requests)[1].where["and"].([]any)
+		assert.Len(t, and, 2)
+		assert.Equal(t, map[string]any{"amountUSD_gt": "0"}, and[0])
+		assert.NotNil(t, and[1].(map[string]any)["timestamp_gte"])
+		assert.NotNil(t, and[1].(map[string]any)["id_not_in"])
+	})
+
+	t.Run("when the cursor fields are excluded", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		opts := &RequestOptions{
+			IncludeFields: []string{"*"},
+			ExcludeFields: []string{"id", "timestamp", "amountUSD"},
+			First:         10,
+			OrderBy:       "timestamp",
+			Block:         100,
+		}
+		var ids []string
+		for swap, err := range client.IterSwaps(context.Background(), opts) {
+			assert.Nil(t, err)
+			ids = append(ids, swap.ID)
+		}
+
+		assert.Len(t, ids, 25)
+		assert.Len(t, *requests, 3)
+		for _, req := range *requests {
+			assert.Contains(t, req.query, "\n\t\tid\n")
+			assert.Contains(t, req.query, "\n\t\ttimestamp\n")
+			assert.NotContains(t, req.query, "amountUSD")
+		}
+		assert.NotNil(t, (*requests)[1].where["timestamp_gte"])
+		assert.Equal(t, []string{"id", "timestamp", "amountUSD"}, opts.ExcludeFields)
+	})
+
+	t.Run("when the caller stops early", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		count := 0
+		for _, err := range client.IterSwaps(context.Background(), &RequestOptions{First: 10, Block: 100}) {
+			assert.Nil(t, err)
+			count++
+			if count == 5 {
+				break
+			}
+		}
+
+		assert.Equal(t, 5, count)
+		assert.Len(t, *requests, 1)
+	})
+
+	t.Run("when BlockNumberGte is provided", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 5)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		count := 0
+		for _, err := range client.IterSwaps(context.Background(), &RequestOptions{BlockNumberGte: 12000}) {
+			assert.Nil(t, err)
+			count++
+		}
+
+		assert.Equal(t, 5, count)
+		assert.Equal(t, float64(12345), (*requests)[1].block)
+
+		for _, err := range client.IterSwaps(context.Background(), &RequestOptions{BlockNumberGte: 13000}) {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "only indexed up to block 12345")
+		}
+	})
+
+	t.Run("when Skip is provided", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		for _, err := range client.IterSwaps(context.Background(), &RequestOptions{Skip: 10}) {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "Skip is not supported")
+		}
+	})
+
+	t.Run("when OrderBy is not a direct field", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		for _, err := range client.IterSwaps(context.Background(), &RequestOptions{OrderBy: "pool"}) {
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "OrderBy must be a direct field")
+		}
+	})
+
+	t.Run("when server returns error", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "swap")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		for _, err := range client.IterSwaps(context.Background(), nil) {
+			assert.NotNil(t, err)
+		}
+	})
+}
+
+func TestListAllSwaps(t *testing.T) {
+	t.Run("when there are no limits", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, summary, err := client.ListAllSwaps(context.Background(), &RequestOptions{First: 10}, nil)
+		assert.Nil(t, err)
+		assert.Len(t, resp.Swaps, 25)
+		assert.Equal(t, "swap-0024", resp.Swaps[24].ID)
+		assert.Equal(t, &PageSummary{Pages: 3, Rows: 25, Block: 12345, Complete: true}, summary)
+		assert.Len(t, *requests, 4)
+	})
+
+	t.Run("when MaxRows is reached", func(t *testing.T) {
+		server, requests := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, summary, err := client.ListAllSwaps(context.Background(), &RequestOptions{First: 10, Block: 100}, &ListAllOptions{MaxRows: 15})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Swaps, 15)
+		assert.Equal(t, &PageSummary{Pages: 2, Rows: 15, Block: 100, Complete: false}, summary)
+		assert.Len(t, *requests, 2)
+	})
+
+	t.Run("when MaxRequests is reached", func(t *testing.T) {
+		server, _ := getPaginationTestServer(t, 25)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, summary, err := client.ListAllSwaps(context.Background(), &RequestOptions{First: 10, Block: 100}, &ListAllOptions{MaxRequests: 1})
+		assert.Nil(t, err)
+		assert.Len(t, resp.Swaps, 10)
+		assert.Equal(t, 1, summary.Pages)
+		assert.False(t, summary.Complete)
+	})
+
+	t.Run("when there are no results", func(t *testing.T) {
+		server, _ := getPaginationTestServer(t, 0)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, summary, err := client.ListAllSwaps(context.Background(), &RequestOptions{Block: 100}, nil)
+		assert.Nil(t, err)
+		assert.Len(t, resp.Swaps, 0)
+		assert.True(t, summary.Complete)
+	})
+
+	t.Run("when limits are negative", func(t *testing.T) {
+		client := NewClient("test", nil)
+
+		_, _, err := client.ListAllSwaps(context.Background(), nil, &ListAllOptions{MaxRows: -1})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "must not be negative")
+	})
+}
+
+func TestPagerCursorWhere(t *testing.T) {
+	t.Run("when caller where overlaps the cursor", func(t *testing.T) {
+		p := &pager{
+			opts:   RequestOptions{OrderBy: "id", OrderDir: "desc", Where: Where{"id_lt": "z"}},
+			cursor: "m",
+		}
+
+		assert.Equal(t, And(Where{"id_lt": "z"}, Where{"id_lt": "m"}), p.cursorWhere())
+	})
+
+	t.Run("when caller where doesn't overlap the cursor", func(t *testing.T) {
+		p := &pager{
+			opts:   RequestOptions{OrderBy: "id", OrderDir: "asc", Where: Where{"pool": "0x0"}},
+			cursor: "m",
+		}
+
+		assert.Equal(t, And(Where{"pool": "0x0"}, Where{"id_gt": "m"}), p.cursorWhere())
+	})
+
+	t.Run("when caller where is a top-level or", func(t *testing.T) {
+		where := Or(Eq("pool", "0x0"), Eq("pool", "0x1"))
+		p := &pager{
+			opts:      RequestOptions{OrderBy: "timestamp", OrderDir: "asc", Where: where},
+			cursor:    "1000",
+			cursorIds: []string{"a"},
+		}
+
+		assert.Equal(t, And(where, Where{"timestamp_gte": "1000", "id_not_in": []string{"a"}}), p.cursorWhere())
+	})
+
+	t.Run("when caller where is empty", func(t *testing.T) {
+		p := &pager{
+			opts:   RequestOptions{OrderBy: "id", OrderDir: "asc"},
+			cursor: "m",
+		}
+
+		assert.Equal(t, Where{"id_gt": "m"}, p.cursorWhere())
+	})
+}
+
+type paginationTestRequest struct {
+	query string
+	block any
+	where map[string]any
+}
+
+// serves swaps from an in-memory data set, honoring first, orderBy and the cursor predicates used by pager
+func getPaginationTestServer(t *testing.T, count int) (*httptest.Server, *[]paginationTestRequest) {
+	var swaps []map[string]any
+	for i := 0; i < count; i++ {
+		swaps = append(swaps, map[string]any{
+			"id":        fmt.Sprintf("swap-%04d", i),
+			"timestamp": fmt.Sprintf("%d", 1000+i/4), // four swaps per timestamp
+			"amountUSD": "1",
+		})
+	}
+
+	requests := &[]paginationTestRequest{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body struct {
+			Query     string
+			Variables map[string]any
+		}
+		assert.Nil(t, json.NewDecoder(r.Body).Decode(&body))
+
+		if strings.Contains(body.Query, "_meta") {
+			*requests = append(*requests, paginationTestRequest{})
+			json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"_meta": map[string]any{"block": map[string]any{"number": 12345}}}})
+			return
+		}
+
+		where, _ := body.Variables["where"].(map[string]any)
+		blockStart := strings.Index(body.Query, "block: {number: ")
+		var block any
+		if blockStart >= 0 {
+			var n float64
+			fmt.Sscanf(body.Query[blockStart:], "block: {number: %g}", &n)
+			block = n
+		}
+		*requests = append(*requests, paginationTestRequest{query: body.Query, block: block, where: where})
+
+		orderBy := body.Variables["orderBy"].(string)
+		sorted := slices.Clone(swaps)
+		slices.SortStableFunc(sorted, func(a, b map[string]any) int {
+			return strings.Compare(a[orderBy].(string), b[orderBy].(string))
+		})
+
+		// the caller's filter and the cursor are combined with `and`
+		predicates := map[string]any{}
+		for k, v := range where {
+			predicates[k] = v
+		}
+		if and, ok := where["and"].([]any); ok {
+			for _, filter := range and {
+				for k, v := range filter.(map[string]any) {
+					predicates[k] = v
+				}
+			}
+		}
+
+		var page []map[string]any
+		for _, swap := range sorted {
+			if v, ok := predicates["id_gt"]; ok && swap["id"].(string) <= v.(string) {
+				continue
+			}
+			if v, ok := predicates["timestamp_gte"]; ok && swap["timestamp"].(string) < v.(string) {
+				continue
+			}
+			if v, ok := predicates["id_not_in"]; ok && slices.Contains(v.([]any), any(swap["id"])) {
+				continue
+			}
+			page = append(page, swap)
+			if len(page) == int(body.Variables["first"].(float64)) {
+				break
+			}
+		}
+
+		json.NewEncoder(w).Encode(map[string]any{"data": map[string]any{"swaps": page}})
+	}))
+
+	return server, requests
+}
@@ -0,0 +1,40 @@
+package unigraphclient
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+)
+
+// returned along with the partial response when RequestOptions.AllowPartialData is set and the subgraph
+// returned data together with errors (e.g. a nested field failed to resolve). every error is available in
+// Errors, and errors.Is / errors.As see through to the underlying *graphql.ResponseError.
+type PartialDataError struct {
+	*graphql.ResponseError
+}
+
+func (e *PartialDataError) Error() string {
+	return fmt.Sprintf("partial data: %s", e.ResponseError.Error())
+}
+
+func (e *PartialDataError) Unwrap() error {
+	return e.ResponseError
+}
+
+// FailedPaths returns the dotted path of every field that failed to resolve, e.g. "pool.token0" or "pools.3.id".
+// errors without a path are skipped.
+func (e *PartialDataError) FailedPaths() []string {
+	var paths []string
+	for _, gqlErr := range e.Errors {
+		if len(gqlErr.Path) == 0 {
+			continue
+		}
+		segments := make([]string, len(gqlErr.Path))
+		for i, segment := range gqlErr.Path {
+			segments[i] = fmt.Sprint(segment)
+		}
+		paths = append(paths, strings.Join(segments, "."))
+	}
+	return paths
+}
@@ -0,0 +1,77 @@
+package unigraphclient
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllowPartialData(t *testing.T) {
+	partialBody := `{
+		"data": {"pool": {"id": "0xpool", "liquidity": "100", "token0": null}},
+		"errors": [
+			{"message": "Failed to resolve token0", "path": ["pool", "token0"]},
+			{"message": "Store error: query timed out"}
+		]
+	}`
+
+	t.Run("when AllowPartialData is set", func(t *testing.T) {
+		server := getTypedTestServer(t, partialBody)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		opts := &RequestOptions{IncludeFields: []string{"id", "liquidity", "token0.symbol"}, AllowPartialData: true}
+		resp, err := client.GetPoolById(context.Background(), "0xpool", opts)
+		assert.NotNil(t, resp)
+		assert.Equal(t, "0xpool", resp.Pool.ID)
+		assert.Equal(t, "100", resp.Pool.Liquidity)
+		assert.Equal(t, "", resp.Pool.Token0.Symbol)
+
+		var partialErr *PartialDataError
+		assert.True(t, errors.As(err, &partialErr))
+		assert.Len(t, partialErr.Errors, 2)
+		assert.Equal(t, []string{"pool.token0"}, partialErr.FailedPaths())
+		assert.True(t, errors.Is(err, graphql.ErrTimeout))
+		assert.Contains(t, err.Error(), "partial data: graphql: Failed to resolve token0")
+	})
+
+	t.Run("when AllowPartialData is not set", func(t *testing.T) {
+		server := getTypedTestServer(t, partialBody)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.GetPoolById(context.Background(), "0xpool", &RequestOptions{IncludeFields: []string{"id"}})
+		assert.Nil(t, resp)
+		assert.NotNil(t, err)
+
+		var partialErr *PartialDataError
+		assert.False(t, errors.As(err, &partialErr))
+	})
+
+	t.Run("when the response has no data", func(t *testing.T) {
+		server := getTypedTestServer(t, `{"data": null, "errors": [{"message": "indexing_error"}]}`)
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.ListPools(context.Background(), &RequestOptions{AllowPartialData: true})
+		assert.Nil(t, resp)
+		assert.True(t, errors.Is(err, graphql.ErrIndexing))
+	})
+
+	t.Run("when the request fails", func(t *testing.T) {
+		server := getTestServer(t, ServerError, "pool")
+		defer server.Close()
+
+		client := NewClient(server.URL, nil)
+
+		resp, err := client.ListPools(context.Background(), &RequestOptions{AllowPartialData: true})
+		assert.Nil(t, resp)
+		assert.Contains(t, err.Error(), "non-200 status code")
+	})
+}
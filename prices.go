@@ -0,0 +1,40 @@
+package unigraphclient
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/v3math"
+)
+
+// Price returns the price of token0 in units of token1 (see the v3math package), computed exactly from sqrtPrice.
+// sqrtPrice, token0.decimals and token1.decimals must be included in the query.
+func (p Pool) Price() (*big.Rat, error) {
+	return sqrtPriceToPrice("pool", p.SqrtPrice, p.Token0.Decimals, p.Token1.Decimals)
+}
+
+// Price returns the price of token0 in units of token1 after the swap, computed exactly from sqrtPriceX96.
+// sqrtPriceX96, token0.decimals and token1.decimals must be included in the query.
+func (s Swap) Price() (*big.Rat, error) {
+	return sqrtPriceToPrice("swap", s.SqrtPriceX96, s.Token0.Decimals, s.Token1.Decimals)
+}
+
+func sqrtPriceToPrice(model string, sqrtPrice string, decimals0 string, decimals1 string) (*big.Rat, error) {
+	if sqrtPrice == "" || decimals0 == "" || decimals1 == "" {
+		return nil, fmt.Errorf("price error: %s is missing the square root price or token decimals (are they included in the query?)", model)
+	}
+	sqrtPriceX96, ok := new(big.Int).SetString(sqrtPrice, 10)
+	if !ok {
+		return nil, fmt.Errorf("price error: invalid square root price %q", sqrtPrice)
+	}
+	d0, err := strconv.Atoi(decimals0)
+	if err != nil {
+		return nil, fmt.Errorf("price error: invalid token0 decimals %q", decimals0)
+	}
+	d1, err := strconv.Atoi(decimals1)
+	if err != nil {
+		return nil, fmt.Errorf("price error: invalid token1 decimals %q", decimals1)
+	}
+	return v3math.SqrtPriceX96ToPrice(sqrtPriceX96, d0, d1)
+}
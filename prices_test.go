@@ -0,0 +1,51 @@
+package unigraphclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPoolPrice(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		pool := Pool{
+			SqrtPrice: "2018382873588440326581633304624437",
+			Token0:    Token{Decimals: "6"},
+			Token1:    Token{Decimals: "18"},
+		}
+		price, err := pool.Price()
+		assert.Nil(t, err)
+		assert.Equal(t, "0.000649004843", price.FloatString(12))
+	})
+
+	t.Run("when decimals are not included in the query", func(t *testing.T) {
+		pool := Pool{SqrtPrice: "2018382873588440326581633304624437"}
+		_, err := pool.Price()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when the square root price is invalid", func(t *testing.T) {
+		pool := Pool{SqrtPrice: "not a number", Token0: Token{Decimals: "6"}, Token1: Token{Decimals: "18"}}
+		_, err := pool.Price()
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSwapPrice(t *testing.T) {
+	t.Run("when successful", func(t *testing.T) {
+		swap := Swap{
+			SqrtPriceX96: "79228162514264337593543950336",
+			Token0:       Token{Decimals: "18"},
+			Token1:       Token{Decimals: "6"},
+		}
+		price, err := swap.Price()
+		assert.Nil(t, err)
+		assert.Equal(t, "1000000000000", price.FloatString(0))
+	})
+
+	t.Run("when decimals are invalid", func(t *testing.T) {
+		swap := Swap{SqrtPriceX96: "79228162514264337593543950336", Token0: Token{Decimals: "x"}, Token1: Token{Decimals: "6"}}
+		_, err := swap.Price()
+		assert.NotNil(t, err)
+	})
+}
@@ -3,6 +3,8 @@ package unigraphclient
 import (
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"slices"
 	"strings"
 
@@ -10,170 +12,368 @@ import (
 )
 
 func constructByIdQuery(id string, model modelFields, opts *RequestOptions) (*graphql.Request, error) {
-	if opts == nil {
-		opts = &RequestOptions{
-			IncludeFields: []string{"*"},
-		}
-	}
+	return constructQuery(ById, id, model, opts)
+}
 
-	err := validateRequestOpts(ById, opts)
+func constructListQuery(model modelFields, opts *RequestOptions) (*graphql.Request, error) {
+	return constructQuery(List, "", model, opts)
+}
+
+func constructQuery(queryType QueryType, id string, model modelFields, opts *RequestOptions) (*graphql.Request, error) {
+	model, opts, err := prepareQuery(queryType, model, opts)
 	if err != nil {
 		return nil, err
 	}
 
-	if slices.Contains(opts.IncludeFields, "*") {
-		fields, err := gatherModelFields(model, opts.ExcludeFields, true)
-		if err != nil {
-			return nil, err
-		}
-		opts.IncludeFields = fields
-	}
-
-	query, err := assembleQuery(ById, model, opts)
+	query, err := assembleQuery(queryType, model, opts)
 	if err != nil {
 		return nil, err
 	}
 
 	req := graphql.NewRequest(query)
-	req.Var("id", id)
-
-	fmt.Println("*** DEBUG req.Query() ***")
-	fmt.Println(req.Query())
-	fmt.Println("*************")
+	setQueryVars(req, queryType, id, "", opts)
 
 	return req, nil
 }
 
-func constructListQuery(model modelFields, opts *RequestOptions) (*graphql.Request, error) {
+// validates opts (filling in defaults), resolves the model against opts.Schema and expands '*' in IncludeFields.
+// returns the model and options to assemble the query with.
+func prepareQuery(queryType QueryType, model modelFields, opts *RequestOptions) (modelFields, *RequestOptions, error) {
 	if opts == nil {
 		opts = &RequestOptions{
 			IncludeFields: []string{"*"},
 		}
 	}
 
-	err := validateRequestOpts(List, opts)
+	err := validateRequestOpts(queryType, opts)
 	if err != nil {
-		return nil, err
+		return modelFields{}, nil, err
+	}
+
+	if opts.Schema != nil {
+		model, err = opts.Schema.model(model.name)
+		if err != nil {
+			return modelFields{}, nil, err
+		}
 	}
 
 	if slices.Contains(opts.IncludeFields, "*") {
 		fields, err := gatherModelFields(model, opts.ExcludeFields, true)
 		if err != nil {
-			return nil, err
+			return modelFields{}, nil, err
 		}
 		opts.IncludeFields = fields
 	}
 
-	query, err := assembleQuery(List, model, opts)
+	return model, opts, nil
+}
+
+// sets the variables used by a root field assembled with assembleRootField, named with the same prefix
+func setQueryVars(req *graphql.Request, queryType QueryType, id string, prefix string, opts *RequestOptions) {
+	switch queryType {
+	case ById:
+		req.Var(prefix+"id", id)
+	case List:
+		req.Var(prefix+"first", opts.First)
+		req.Var(prefix+"skip", opts.Skip)
+		req.Var(prefix+"orderBy", opts.OrderBy)
+		req.Var(prefix+"orderDir", opts.OrderDir)
+		if len(opts.Where) > 0 {
+			req.Var(prefix+"where", opts.Where)
+		}
+	}
+	setDerivedVars(req, prefix, opts)
+}
+
+// assembles a properly formatted graphql query based on the provided includeFields
+func assembleQuery(queryType QueryType, model modelFields, opts *RequestOptions) (string, error) {
+	decls, field, err := assembleRootField(queryType, model, opts, "", "")
 	if err != nil {
-		return nil, err
+		return "", err
 	}
 
-	req := graphql.NewRequest(query)
-	req.Var("first", opts.First)
-	req.Var("skip", opts.Skip)
-	req.Var("orderBy", opts.OrderBy)
-	req.Var("orderDir", opts.OrderDir)
+	name := model.name
+	if queryType == List {
+		name = pluralizeModelName(model.name)
+	}
+	parts := []string{fmt.Sprintf("query %s(%s) {", name, strings.Join(decls, ", "))}
+	parts = append(parts, field...)
 
-	fmt.Println("*** DEBUG req.Query() ***")
-	fmt.Println(req.Query())
-	fmt.Println("*************")
+	if hasStalenessGuard(opts) {
+		// read the indexing status in the same query, so staleness is checked against the state the data was read at
+		parts = append(parts, "	_meta {", "		block {", "			number", "			timestamp", "		}", "	}")
+	}
 
-	return req, nil
+	parts = append(parts, "}")
+	query := strings.Join(parts, "\n")
+
+	return query, nil
 }
 
-// assembles a properly formatted graphql query based on the provided includeFields
-func assembleQuery(queryType QueryType, model modelFields, opts *RequestOptions) (string, error) {
-	var parts []string
+// assembles a root field of a query (e.g. `pool(id: $id) {...}`) and the declarations of the variables it uses (e.g.
+// `$id: ID!`). a batch selects several root fields in one query, so each is named with an alias and its variables
+// with a prefix, e.g. `q3: pool(id: $q3_id) {...}`.
+func assembleRootField(queryType QueryType, model modelFields, opts *RequestOptions, alias string, prefix string) ([]string, []string, error) {
+	var decls []string
+	var root string
 
 	var blockSubstr string = ""
-	if opts.Block != 0 {
+	switch {
+	case opts.Block != 0:
 		blockSubstr = fmt.Sprintf(", block: {number: %d}", opts.Block)
+	case opts.BlockHash != "":
+		blockSubstr = fmt.Sprintf(", block: {hash: \"%s\"}", opts.BlockHash)
+	case opts.BlockNumberGte != 0:
+		blockSubstr = fmt.Sprintf(", block: {number_gte: %d}", opts.BlockNumberGte)
+	}
+
+	derivedDecls, err := validateDerivedOpts(model, opts, prefix)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aliasSubstr string = ""
+	if alias != "" {
+		aliasSubstr = alias + ": "
 	}
 
 	switch queryType {
 	case ById:
-		parts = []string{
-			fmt.Sprintf("query %s($id: ID!) {", model.name),
-			fmt.Sprintf("	%s(id: $id%s) {", model.name, blockSubstr),
-		}
+		decls = []string{fmt.Sprintf("$%sid: ID!", prefix)}
+		root = fmt.Sprintf("	%s%s(id: $%sid%s) {", aliasSubstr, model.name, prefix, blockSubstr)
 	case List:
-		parts = []string{
-			fmt.Sprintf("query %s($first: Int!, $skip: Int!, $orderBy: String!, $orderDir: String!) {", pluralizeModelName(model.name)),
-			fmt.Sprintf("	%s(first: $first, skip: $skip, orderBy: $orderBy, orderDirection: $orderDir%s) {", pluralizeModelName(model.name), blockSubstr),
+		var whereArgSubstr string = ""
+		decls = []string{
+			fmt.Sprintf("$%sfirst: Int!", prefix),
+			fmt.Sprintf("$%sskip: Int!", prefix),
+			fmt.Sprintf("$%sorderBy: String!", prefix),
+			fmt.Sprintf("$%sorderDir: String!", prefix),
 		}
+		if len(opts.Where) > 0 {
+			if err := validateWhere(model, opts.Where); err != nil {
+				return nil, nil, err
+			}
+			decls = append(decls, fmt.Sprintf("$%swhere: %s", prefix, filterTypeName(model.name)))
+			whereArgSubstr = fmt.Sprintf(", where: $%swhere", prefix)
+		}
+		root = fmt.Sprintf("	%s%s(first: $%sfirst, skip: $%sskip, orderBy: $%sorderBy, orderDirection: $%sorderDir%s%s) {",
+			aliasSubstr, pluralizeModelName(model.name), prefix, prefix, prefix, prefix, whereArgSubstr, blockSubstr)
 	default:
-		return "", fmt.Errorf("unrecognized query type (%v)", queryType)
+		return nil, nil, fmt.Errorf("unrecognized query type (%v)", queryType)
 	}
+	decls = append(decls, derivedDecls...)
 
-	var refFieldMap map[string]fieldRefs = make(map[string]fieldRefs)
+	maxDepth := opts.MaxDepth
+	if maxDepth == 0 {
+		maxDepth = defaultMaxDepth
+	}
 
-	// TODO: think about ways to make the rest of this function more comprehensible
+	selected := &selection{model: model}
 	for _, field := range opts.IncludeFields {
-		isRef := false
-		for k, v := range model.reference {
-			prefix := fmt.Sprintf("%s.", k)
-			if strings.HasPrefix(field, prefix) {
-				isRef = true
-				refModel, ok := modelMap[v]
-				if !ok {
-					return "", fmt.Errorf("reference field not found (%s)", k)
-				}
-				fieldWithoutPrefix := cutPrefix(field, prefix)
-				fieldRef := refFieldMap[k]
-				if strings.Contains(fieldWithoutPrefix, ".") {
-					subRefFields := strings.Split(fieldWithoutPrefix, ".")
-					subRefModel, ok := modelMap[refModel.reference[subRefFields[0]]]
-					if !ok {
-						return "", fmt.Errorf("sub-reference field not found (%s)", fieldWithoutPrefix)
-					}
-					if !validateField(subRefModel, subRefFields[1]) {
-						return "", fmt.Errorf("unrecognized field given in opts.IncludeFields (%s)", field)
-					}
-					fieldRef.refs = append(fieldRef.refs, fieldWithoutPrefix)
-				} else {
-					if !validateField(refModel, fieldWithoutPrefix) {
-						return "", fmt.Errorf("unrecognized field given in opts.IncludeFields (%s)", field)
-					}
-					fieldRef.directs = append(fieldRef.directs, fieldWithoutPrefix)
-				}
-				refFieldMap[k] = fieldRef
-				break
+		if strings.Count(field, ".") > maxDepth {
+			return nil, nil, fmt.Errorf("request options error: field exceeds the maximum reference depth of %d (%s)", maxDepth, field)
+		}
+		if err := selected.add(field, "", field); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	lines := []string{root}
+	lines = append(lines, selected.render("		", "", prefix, opts)...)
+	lines = append(lines, "	}")
+
+	return decls, lines, nil
+}
+
+// validates opts.Derived against the model and the selected fields, and returns the variable declarations for
+// any derived where clauses e.g. `$swaps_where: Swap_filter`, with their names prefixed by prefix
+func validateDerivedOpts(model modelFields, opts *RequestOptions, prefix string) ([]string, error) {
+	paths := make([]string, 0, len(opts.Derived))
+	for path := range opts.Derived {
+		paths = append(paths, path)
+	}
+	slices.Sort(paths)
+
+	var decls []string
+	for _, path := range paths {
+		derivedOpts := opts.Derived[path]
+
+		parent := model
+		segments := strings.Split(path, ".")
+		for _, segment := range segments[:len(segments)-1] {
+			ref, ok := parent.relation(segment)
+			if !ok {
+				return nil, fmt.Errorf("unrecognized derived field given in opts.Derived (%s)", path)
 			}
+			parent, _ = parent.lookup(ref)
 		}
-		if !isRef {
-			if !validateField(model, field) {
-				return "", fmt.Errorf("unrecognized field given in opts.IncludeFields (%s)", field)
+		derivedName, ok := parent.derived[segments[len(segments)-1]]
+		if !ok {
+			return nil, fmt.Errorf("unrecognized derived field given in opts.Derived (%s)", path)
+		}
+		derivedModel, ok := parent.lookup(derivedName)
+		if !ok {
+			return nil, fmt.Errorf("derived field not found (%s)", path)
+		}
+
+		selected := slices.ContainsFunc(opts.IncludeFields, func(field string) bool {
+			return strings.HasPrefix(field, path+".")
+		})
+		if !selected {
+			return nil, fmt.Errorf("request options error: opts.Derived field is not selected in IncludeFields (%s)", path)
+		}
+		if derivedOpts.First < 0 || derivedOpts.First > 1000 {
+			return nil, fmt.Errorf("request options error: opts.Derived First must be between 0 and 1000 (%s)", path)
+		}
+		if derivedOpts.Skip < 0 {
+			return nil, fmt.Errorf("request options error: opts.Derived Skip must not be negative (%s)", path)
+		}
+		if derivedOpts.OrderBy != "" && !validateField(derivedModel, derivedOpts.OrderBy) {
+			return nil, fmt.Errorf("request options error: opts.Derived OrderBy must be a direct field (%s)", path)
+		}
+		if derivedOpts.OrderDir != "" && derivedOpts.OrderDir != "asc" && derivedOpts.OrderDir != "desc" {
+			return nil, errors.New("request options error: 'asc' and 'desc' are the only valid options for OrderDir")
+		}
+		if len(derivedOpts.Where) > 0 {
+			if err := validateWhere(derivedModel, derivedOpts.Where); err != nil {
+				return nil, err
 			}
-			parts = append(parts, fmt.Sprintf("		%s", field))
+			decls = append(decls, fmt.Sprintf("$%s%s: %s", prefix, derivedWhereVar(path), filterTypeName(derivedModel.name)))
 		}
 	}
 
-	for k, v := range refFieldMap {
-		parts = append(parts, fmt.Sprintf("		%s {", k))
-		if len(v.directs) > 0 {
-			parts = append(parts, "			"+strings.Join(v.directs, "\n			"))
+	return decls, nil
+}
+
+// returns the arguments for a derived field e.g. `(first: 10, orderBy: timestamp, where: $swaps_where)`, or an
+// empty string if the field isn't derived or has no arguments
+func derivedArgs(parent modelFields, field string, path string, prefix string, opts *RequestOptions) string {
+	if _, ok := parent.derived[field]; !ok {
+		return ""
+	}
+	derivedOpts, ok := opts.Derived[path]
+	if !ok {
+		return ""
+	}
+
+	var args []string
+	if derivedOpts.First != 0 {
+		args = append(args, fmt.Sprintf("first: %d", derivedOpts.First))
+	}
+	if derivedOpts.Skip != 0 {
+		args = append(args, fmt.Sprintf("skip: %d", derivedOpts.Skip))
+	}
+	if derivedOpts.OrderBy != "" {
+		args = append(args, fmt.Sprintf("orderBy: %s", derivedOpts.OrderBy))
+	}
+	if derivedOpts.OrderDir != "" {
+		args = append(args, fmt.Sprintf("orderDirection: %s", derivedOpts.OrderDir))
+	}
+	if len(derivedOpts.Where) > 0 {
+		args = append(args, fmt.Sprintf("where: $%s%s", prefix, derivedWhereVar(path)))
+	}
+	if len(args) == 0 {
+		return ""
+	}
+	return "(" + strings.Join(args, ", ") + ")"
+}
+
+// returns the variable name for a derived where clause e.g. `pool.swaps` -> `pool_swaps_where`
+func derivedWhereVar(path string) string {
+	return strings.ReplaceAll(path, ".", "_") + "_where"
+}
+
+func setDerivedVars(req *graphql.Request, prefix string, opts *RequestOptions) {
+	for path, derivedOpts := range opts.Derived {
+		if len(derivedOpts.Where) > 0 {
+			req.Var(prefix+derivedWhereVar(path), derivedOpts.Where)
 		}
-		subRefMap := make(map[string][]string)
-		for _, ref := range v.refs {
-			fieldSplit := strings.Split(ref, ".")
-			if len(fieldSplit) < 2 {
-				return "", fmt.Errorf("error parsing reference field (%s)", ref)
+	}
+}
+
+// node in the selection set of a query: the direct fields and nested (reference or derived) selections of a model
+type selection struct {
+	name     string
+	model    modelFields
+	fields   []string
+	children []*selection
+}
+
+// adds a dotted field (e.g. `pool.token0.symbol` or `pool.*`) to the selection set, validating each segment against
+// the model. path is the dotted path of the selection and includeField is the field as given in opts.IncludeFields.
+func (s *selection) add(field string, path string, includeField string) error {
+	segment, rest, isRef := strings.Cut(field, ".")
+	if !isRef {
+		if segment == "*" && path != "" {
+			fields, err := gatherModelFields(s.model, nil, false)
+			if err != nil {
+				return err
 			}
-			parent := fieldSplit[0]
-			child := fieldSplit[1]
-			subRefMap[parent] = append(subRefMap[parent], child)
+			for _, f := range fields {
+				if err := s.add(f, path, includeField); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		if !validateField(s.model, segment) {
+			return fmt.Errorf("unrecognized field given in opts.IncludeFields (%s)", includeField)
 		}
-		for subK, subV := range subRefMap {
-			parts = append(parts, fmt.Sprintf("			%s {", subK), "				"+strings.Join(subV, "\n				"), "			}")
+		if !slices.Contains(s.fields, segment) {
+			s.fields = append(s.fields, segment)
 		}
-		parts = append(parts, "		}")
+		return nil
 	}
 
-	parts = append(parts, "	}", "}")
-	query := strings.Join(parts, "\n")
+	ref, ok := s.model.relation(segment)
+	if !ok {
+		return fmt.Errorf("unrecognized field given in opts.IncludeFields (%s)", includeField)
+	}
+	childPath := segment
+	if path != "" {
+		childPath = path + "." + segment
+	}
+	child := s.child(segment)
+	if child == nil {
+		refModel, ok := s.model.lookup(ref)
+		if !ok {
+			return fmt.Errorf("reference field not found (%s)", childPath)
+		}
+		child = &selection{name: segment, model: refModel}
+		s.children = append(s.children, child)
+	}
+	return child.add(rest, childPath, includeField)
+}
 
-	return query, nil
+func (s *selection) child(name string) *selection {
+	for _, child := range s.children {
+		if child.name == name {
+			return child
+		}
+	}
+	return nil
+}
+
+// renders the selection set as indented query lines. fields and nested selections are sorted by name, so the
+// query text doesn't depend on the order of opts.IncludeFields.
+func (s *selection) render(indent string, path string, prefix string, opts *RequestOptions) []string {
+	var lines []string
+	for _, field := range slices.Sorted(slices.Values(s.fields)) {
+		lines = append(lines, indent+field)
+	}
+	children := slices.SortedFunc(slices.Values(s.children), func(a, b *selection) int {
+		return strings.Compare(a.name, b.name)
+	})
+	for _, child := range children {
+		childPath := child.name
+		if path != "" {
+			childPath = path + "." + child.name
+		}
+		lines = append(lines, fmt.Sprintf("%s%s%s {", indent, child.name, derivedArgs(s.model, child.name, childPath, prefix, opts)))
+		lines = append(lines, child.render(indent+"	", childPath, prefix, opts)...)
+		lines = append(lines, indent+"}")
+	}
+	return lines
 }
 
 // recursively gathers all fields for the given model, while honoring fields to be excluded
@@ -186,7 +386,7 @@ func gatherModelFields(model modelFields, excludeFields []string, populateRefs b
 	}
 	for k, v := range model.reference {
 		if populateRefs {
-			refModel, ok := modelMap[v]
+			refModel, ok := model.lookup(v)
 			if !ok {
 				return nil, fmt.Errorf("reference field not found (%s)", k)
 			}
@@ -210,13 +410,171 @@ func gatherModelFields(model modelFields, excludeFields []string, populateRefs b
 	return fields, nil
 }
 
+// maximum number of references that can be traversed by a field in opts.IncludeFields, unless opts.MaxDepth is set
+const defaultMaxDepth int = 4
+
+// filter operators supported by the graph, appended to field names in where clauses (e.g. `timestamp_gt`)
+var whereOperators []string = []string{
+	"_not",
+	"_gt",
+	"_lt",
+	"_gte",
+	"_lte",
+	"_in",
+	"_not_in",
+	"_contains",
+	"_contains_nocase",
+	"_not_contains",
+	"_not_contains_nocase",
+	"_starts_with",
+	"_starts_with_nocase",
+	"_not_starts_with",
+	"_not_starts_with_nocase",
+	"_ends_with",
+	"_ends_with_nocase",
+	"_not_ends_with",
+	"_not_ends_with_nocase",
+}
+
+// validates each where predicate against the fields of the given model. logical (`and`/`or`) and nested (`pool_`)
+// filters are validated recursively, with nested filters resolved against the referenced model.
+func validateWhere(model modelFields, where Where) error {
+	for key, value := range where {
+		if key == "and" || key == "or" {
+			filters, ok := toWhereList(value)
+			if !ok {
+				return fmt.Errorf("opts.Where value for %s must be a list of filters", key)
+			}
+			for _, filter := range filters {
+				if err := validateWhere(model, filter); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if refName, found := strings.CutSuffix(key, "_"); found {
+			ref, ok := model.relation(refName)
+			if !ok {
+				return fmt.Errorf("unrecognized reference field given in opts.Where (%s)", key)
+			}
+			refModel, ok := model.lookup(ref)
+			if !ok {
+				return fmt.Errorf("reference field not found (%s)", refName)
+			}
+			filter, ok := toWhere(value)
+			if !ok {
+				return fmt.Errorf("opts.Where value for %s must be a filter", key)
+			}
+			if err := validateWhere(refModel, filter); err != nil {
+				return err
+			}
+			continue
+		}
+		field, operator := parseWhereKey(model, key)
+		if field == "" {
+			return fmt.Errorf("unrecognized field given in opts.Where (%s)", key)
+		}
+		if operator == "_in" || operator == "_not_in" {
+			kind := reflect.ValueOf(value).Kind()
+			if kind != reflect.Slice && kind != reflect.Array {
+				return fmt.Errorf("opts.Where value for %s must be a list", key)
+			}
+		}
+	}
+	return nil
+}
+
+func toWhere(value any) (Where, bool) {
+	switch v := value.(type) {
+	case Where:
+		return v, true
+	case map[string]any:
+		return Where(v), true
+	}
+	return nil, false
+}
+
+func toWhereList(value any) ([]Where, bool) {
+	switch v := value.(type) {
+	case []Where:
+		return v, true
+	case []map[string]any:
+		filters := make([]Where, 0, len(v))
+		for _, f := range v {
+			filters = append(filters, Where(f))
+		}
+		return filters, true
+	case []any:
+		filters := make([]Where, 0, len(v))
+		for _, f := range v {
+			filter, ok := toWhere(f)
+			if !ok {
+				return nil, false
+			}
+			filters = append(filters, filter)
+		}
+		return filters, true
+	}
+	return nil, false
+}
+
+// splits a where key into its model field and operator suffix. the field is empty if the key doesn't match the model.
+func parseWhereKey(model modelFields, key string) (string, string) {
+	if isFilterableField(model, key) {
+		return key, ""
+	}
+	for _, operator := range whereOperators {
+		field, found := strings.CutSuffix(key, operator)
+		if found && isFilterableField(model, field) {
+			return field, operator
+		}
+	}
+	return "", ""
+}
+
+func isFilterableField(model modelFields, field string) bool {
+	if validateField(model, field) {
+		return true
+	}
+	_, ok := model.reference[field]
+	return ok
+}
+
+// returns the graphql input type name used for where clauses e.g. `pool` -> `Pool_filter`
+func filterTypeName(name string) string {
+	if name == "" {
+		return "_filter"
+	}
+	return strings.ToUpper(name[:1]) + name[1:] + "_filter"
+}
+
 func validateField(model modelFields, field string) bool {
 	return slices.Contains(model.direct, field)
 }
 
-func cutPrefix(s string, prefix string) string {
-	cut, _ := strings.CutPrefix(s, prefix)
-	return cut
+var blockHashRegexp = regexp.MustCompile(`^0x[0-9a-fA-F]{64}$`)
+
+// validates the block selector of opts (Block, BlockHash or BlockNumberGte)
+func validateBlockOpts(opts *RequestOptions) error {
+	if opts.Block < 0 || opts.BlockNumberGte < 0 {
+		return errors.New("request options error: Block and BlockNumberGte must not be negative")
+	}
+
+	selectors := 0
+	for _, set := range []bool{opts.Block != 0, opts.BlockHash != "", opts.BlockNumberGte != 0} {
+		if set {
+			selectors++
+		}
+	}
+	if selectors > 1 {
+		return errors.New("request options error: only one of Block, BlockHash and BlockNumberGte can be provided")
+	}
+
+	if opts.BlockHash != "" && !blockHashRegexp.MatchString(opts.BlockHash) {
+		return fmt.Errorf("request options error: BlockHash must be a 0x-prefixed 32 byte hex string (%s)", opts.BlockHash)
+	}
+
+	return nil
 }
 
 func validateRequestOpts(queryType QueryType, opts *RequestOptions) error {
@@ -224,6 +582,18 @@ func validateRequestOpts(queryType QueryType, opts *RequestOptions) error {
 		opts.IncludeFields = []string{"*"}
 	}
 
+	if opts.MaxDepth < 0 {
+		return errors.New("request options error: MaxDepth must not be negative")
+	}
+
+	if opts.MaxBlockLag < 0 || opts.MaxAge < 0 {
+		return errors.New("request options error: MaxBlockLag and MaxAge must not be negative")
+	}
+
+	if err := validateBlockOpts(opts); err != nil {
+		return err
+	}
+
 	if !slices.Contains(opts.IncludeFields, "*") && len(opts.ExcludeFields) > 0 {
 		return errors.New("request options error: ExcludeFields can only be provided when IncludeFields is set to '*'")
 	}
@@ -233,6 +603,9 @@ func validateRequestOpts(queryType QueryType, opts *RequestOptions) error {
 		if opts.First != 0 || opts.Skip != 0 || opts.OrderBy != "" || opts.OrderDir != "" {
 			return errors.New("request options error: List query options (First, Skip, OrderBy, OrderDir) should not be provided for ById queries")
 		}
+		if len(opts.Where) > 0 {
+			return errors.New("request options error: Where should not be provided for ById queries")
+		}
 	case List:
 		if opts.First > 1000 {
 			return errors.New("request options error: First is too large (must be <= 1000)")
@@ -255,11 +628,8 @@ func validateRequestOpts(queryType QueryType, opts *RequestOptions) error {
 }
 
 func pluralizeModelName(name string) string {
-	if name == "factory" {
-		return "factories"
-	}
-	if name == "flash" {
-		return "flashes"
+	if plural, ok := modelPlurals[name]; ok {
+		return plural
 	}
 	return fmt.Sprintf("%ss", name)
 }
@@ -2,7 +2,9 @@ package unigraphclient
 
 import (
 	"slices"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -75,6 +77,43 @@ func TestConstructListQuery(t *testing.T) {
 		assert.Equal(t, 50, first)
 	})
 
+	t.Run("when opts.Where is set", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+			Where: Where{
+				"pool":         "0x8ad599c3a0ff1de082011efddc58f1908eb6e6d8",
+				"timestamp_gt": 1700000000,
+			},
+		}
+		req, err := constructListQuery(SwapFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, req.Query(), "$where: Swap_filter")
+		assert.Contains(t, req.Query(), "where: $where")
+
+		vars := req.Vars()
+		where, ok := vars["where"]
+		assert.True(t, ok)
+		assert.Equal(t, opts.Where, where)
+	})
+
+	t.Run("when equivalent request options are given", func(t *testing.T) {
+		req1, err := constructListQuery(SwapFields, &RequestOptions{
+			IncludeFields: []string{"id", "pool.id", "amountUSD"},
+			Where:         Where{"amountUSD_gt": "1", "pool": "0x0"},
+		})
+		assert.Nil(t, err)
+
+		req2, err := constructListQuery(SwapFields, &RequestOptions{
+			IncludeFields: []string{"amountUSD", "pool.id", "id"},
+			Where:         Where{"pool": "0x0", "amountUSD_gt": "1"},
+		})
+		assert.Nil(t, err)
+
+		assert.Equal(t, req1.Query(), req2.Query())
+		assert.Equal(t, req1.Hash(), req2.Hash())
+	})
+
 	t.Run("when query assembly fails", func(t *testing.T) {
 		opts := &RequestOptions{
 			IncludeFields: []string{"not found"},
@@ -151,6 +190,184 @@ func TestAssembleQuery(t *testing.T) {
 		assert.Greater(t, len(listQuery), 0)
 	})
 
+	t.Run("when opts.BlockHash is set", func(t *testing.T) {
+		hash := "0x" + strings.Repeat("ab", 32)
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+			BlockHash:     hash,
+		}
+		query, err := assembleQuery(ById, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, query, `pool(id: $id, block: {hash: "`+hash+`"}) {`)
+	})
+
+	t.Run("when opts.BlockNumberGte is set", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields:  []string{"id"},
+			BlockNumberGte: 1000000,
+		}
+		query, err := assembleQuery(List, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, query, "orderDirection: $orderDir, block: {number_gte: 1000000}) {")
+	})
+
+	t.Run("when a staleness guard is set", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+			MaxAge:        time.Minute,
+		}
+		query, err := assembleQuery(ById, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "query pool($id: ID!) {\n\tpool(id: $id) {\n\t\tid\n\t}\n\t_meta {\n\t\tblock {\n\t\t\tnumber\n\t\t\ttimestamp\n\t\t}\n\t}\n}", query)
+	})
+
+	t.Run("when opts.Where is not set", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+		}
+		query, err := assembleQuery(List, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.NotContains(t, query, "where")
+	})
+
+	t.Run("when opts.Where is invalid", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id"},
+			Where:         Where{"notFound_gt": 1},
+		}
+		_, err := assembleQuery(List, PoolFields, opts)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "unrecognized field given in opts.Where")
+	})
+
+	t.Run("when derived fields are selected", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id", "swaps.amountUSD", "swaps.token0.symbol", "poolDayData.volumeUSD"},
+			Derived: map[string]DerivedOptions{
+				"swaps": {
+					First:    10,
+					OrderBy:  "timestamp",
+					OrderDir: "desc",
+					Where:    Where{"amountUSD_gt": "1000"},
+				},
+			},
+		}
+		query, err := assembleQuery(ById, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, query, "query pool($id: ID!, $swaps_where: Swap_filter) {")
+		assert.Contains(t, query, "swaps(first: 10, orderBy: timestamp, orderDirection: desc, where: $swaps_where) {")
+		assert.Contains(t, query, "poolDayData {")
+		assert.Contains(t, query, "token0 {")
+	})
+
+	t.Run("when derived fields are selected through a reference", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id", "pool.swaps.id"},
+			Derived: map[string]DerivedOptions{
+				"pool.swaps": {First: 5, Where: Where{"origin": "0x0"}},
+			},
+		}
+		query, err := assembleQuery(List, TickFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, query, "$pool_swaps_where: Swap_filter) {")
+		assert.Contains(t, query, "swaps(first: 5, where: $pool_swaps_where) {")
+	})
+
+	t.Run("when fields are nested more than two levels deep", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id", "pool.token0.symbol", "pool.token0.decimals", "pool.feeTier"},
+		}
+		query, err := assembleQuery(ById, PositionFields, opts)
+
+		assert.Nil(t, err)
+		assert.Contains(t, query, "		pool {\n			feeTier\n			token0 {\n				decimals\n				symbol\n			}\n		}")
+	})
+
+	t.Run("when the query is printed", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"timestamp", "swaps.amountUSD", "id", "swaps.id"},
+			Where:         Where{"timestamp_gt": "0"},
+			Derived:       map[string]DerivedOptions{"swaps": {First: 5, OrderBy: "logIndex"}},
+			Block:         100,
+		}
+		query, err := assembleQuery(List, TransactionFields, opts)
+
+		want := strings.Join([]string{
+			"query transactions($first: Int!, $skip: Int!, $orderBy: String!, $orderDir: String!, $where: Transaction_filter) {",
+			"	transactions(first: $first, skip: $skip, orderBy: $orderBy, orderDirection: $orderDir, where: $where, block: {number: 100}) {",
+			"		id",
+			"		timestamp",
+			"		swaps(first: 5, orderBy: logIndex) {",
+			"			amountUSD",
+			"			id",
+			"		}",
+			"	}",
+			"}",
+		}, "\n")
+
+		assert.Nil(t, err)
+		assert.Equal(t, want, query)
+	})
+
+	t.Run("when IncludeFields are given in a different order", func(t *testing.T) {
+		fields := []string{"id", "token0.symbol", "token1.symbol", "feeTier", "token0.decimals", "liquidity"}
+		query1, err := assembleQuery(ById, PoolFields, &RequestOptions{IncludeFields: fields})
+		assert.Nil(t, err)
+
+		slices.Reverse(fields)
+		query2, err := assembleQuery(ById, PoolFields, &RequestOptions{IncludeFields: fields})
+		assert.Nil(t, err)
+
+		assert.Equal(t, query1, query2)
+	})
+
+	t.Run("when a reference wildcard is given", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"pool.*"},
+		}
+		query, err := assembleQuery(ById, TickFields, opts)
+
+		assert.Nil(t, err)
+		for _, field := range PoolFields.direct {
+			assert.Contains(t, query, "			"+field+"\n")
+		}
+		assert.Contains(t, query, "			token0 {\n				id\n			}")
+		assert.NotContains(t, query, "swaps")
+	})
+
+	t.Run("when opts.MaxDepth is set", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"pool.token0.symbol"},
+			MaxDepth:      1,
+		}
+		_, err := assembleQuery(ById, PositionFields, opts)
+
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "exceeds the maximum reference depth of 1")
+
+		opts.MaxDepth = 2
+		_, err = assembleQuery(ById, PositionFields, opts)
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("when fields are duplicated", func(t *testing.T) {
+		opts := &RequestOptions{
+			IncludeFields: []string{"id", "id", "token0.id", "token0.id"},
+		}
+		query, err := assembleQuery(ById, PoolFields, opts)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 2, strings.Count(query, "id\n"))
+	})
+
 	t.Run("when query type is unrecognized", func(t *testing.T) {
 		opts := &RequestOptions{
 			IncludeFields: []string{"id"},
@@ -238,6 +455,37 @@ func TestAssembleQuery_FieldCombinations(t *testing.T) {
 			includeFields: []string{"*", "id", "decimals", "whitelistPools.txCount"},
 			excludeFields: []string{},
 		},
+		"position deep include (valid)": {
+			model:         PositionFields,
+			includeFields: []string{"id", "pool.token0.symbol", "pool.token0.whitelistPools.feeTier", "transaction.swaps.pool.id"},
+			excludeFields: []string{},
+		},
+		"position deep include (invalid)": {
+			model:         PositionFields,
+			includeFields: []string{"id", "pool.token0.whitelistPools.notFound"},
+			excludeFields: []string{},
+			wantErr:       true,
+			wantErrMsg:    "unrecognized field given in opts.IncludeFields (pool.token0.whitelistPools.notFound)",
+		},
+		"position deep include (invalid reference)": {
+			model:         PositionFields,
+			includeFields: []string{"id", "pool.notFound.symbol"},
+			excludeFields: []string{},
+			wantErr:       true,
+			wantErrMsg:    "unrecognized field given in opts.IncludeFields (pool.notFound.symbol)",
+		},
+		"position reference wildcard (valid)": {
+			model:         PositionFields,
+			includeFields: []string{"id", "pool.*", "pool.token0.*"},
+			excludeFields: []string{},
+		},
+		"position too deep (invalid)": {
+			model:         PositionFields,
+			includeFields: []string{"pool.token0.whitelistPools.token1.whitelistPools.id"},
+			excludeFields: []string{},
+			wantErr:       true,
+			wantErrMsg:    "exceeds the maximum reference depth of 4",
+		},
 		"token exclude (valid)": {
 			model:         TokenFields,
 			includeFields: []string{"*"},
@@ -275,6 +523,96 @@ func TestAssembleQuery_FieldCombinations(t *testing.T) {
 	}
 }
 
+func TestValidateDerivedOpts(t *testing.T) {
+	tests := map[string]struct {
+		model         modelFields
+		includeFields []string
+		derived       map[string]DerivedOptions
+		wantVars      []string
+		wantErrMsg    string
+	}{
+		"when there are no derived options": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+		},
+		"when derived options are valid": {
+			model:         TransactionFields,
+			includeFields: []string{"flashed.id", "swaps.id"},
+			derived: map[string]DerivedOptions{
+				"swaps":   {Where: Where{"pool": "0x0"}},
+				"flashed": {First: 1000, Skip: 10, OrderBy: "amountUSD", OrderDir: "asc", Where: Where{"amountUSD_gt": "0"}},
+			},
+			wantVars: []string{"$flashed_where: Flash_filter", "$swaps_where: Swap_filter"},
+		},
+		"when field is not derived": {
+			model:         PoolFields,
+			includeFields: []string{"token0.id"},
+			derived:       map[string]DerivedOptions{"token0": {First: 1}},
+			wantErrMsg:    "unrecognized derived field given in opts.Derived (token0)",
+		},
+		"when path is not found": {
+			model:         SwapFields,
+			includeFields: []string{"id"},
+			derived:       map[string]DerivedOptions{"notFound.swaps": {First: 1}},
+			wantErrMsg:    "unrecognized derived field given in opts.Derived (notFound.swaps)",
+		},
+		"when derived field is not selected": {
+			model:         PoolFields,
+			includeFields: []string{"id"},
+			derived:       map[string]DerivedOptions{"swaps": {First: 1}},
+			wantErrMsg:    "not selected in IncludeFields (swaps)",
+		},
+		"when First is too large": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+			derived:       map[string]DerivedOptions{"swaps": {First: 1001}},
+			wantErrMsg:    "First must be between 0 and 1000",
+		},
+		"when Skip is negative": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+			derived:       map[string]DerivedOptions{"swaps": {Skip: -1}},
+			wantErrMsg:    "Skip must not be negative",
+		},
+		"when OrderBy is invalid": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+			derived:       map[string]DerivedOptions{"swaps": {OrderBy: "timestamp desc"}},
+			wantErrMsg:    "OrderBy must be a direct field",
+		},
+		"when OrderDir is invalid": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+			derived:       map[string]DerivedOptions{"swaps": {OrderDir: "up"}},
+			wantErrMsg:    "'asc' and 'desc' are the only valid options for OrderDir",
+		},
+		"when Where is invalid": {
+			model:         PoolFields,
+			includeFields: []string{"swaps.id"},
+			derived:       map[string]DerivedOptions{"swaps": {Where: Where{"feeTier": "500"}}},
+			wantErrMsg:    "unrecognized field given in opts.Where (feeTier)",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := &RequestOptions{
+				IncludeFields: test.includeFields,
+				Derived:       test.derived,
+			}
+			vars, err := validateDerivedOpts(test.model, opts, "")
+
+			if test.wantErrMsg != "" {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.wantErrMsg)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, test.wantVars, vars)
+			}
+		})
+	}
+}
+
 func TestGatherModelFields(t *testing.T) {
 	tests := map[string]struct {
 		model         modelFields
@@ -315,13 +653,13 @@ func TestGatherModelFields(t *testing.T) {
 		"when model has references, excludeFields is empty, and populateRefs is true": {
 			model:        PoolFields,
 			populateRefs: true,
-			wantLen:      59,
+			wantLen:      57,
 		},
 		"when model has references, excludeFields is not empty, and populateRefs is true": {
 			model:         PoolFields,
 			excludeFields: []string{"feeTier", "token0.id"},
 			populateRefs:  true,
-			wantLen:       57,
+			wantLen:       55,
 		},
 	}
 
@@ -340,54 +678,194 @@ func TestGatherModelFields(t *testing.T) {
 	}
 }
 
-func TestValidateField(t *testing.T) {
+func TestDefaultQueriesSkipEntityLists(t *testing.T) {
+	for name, model := range map[string]modelFields{"pool": PoolFields, "token": TokenFields, "swap": SwapFields} {
+		t.Run("when the "+name+" query includes all fields", func(t *testing.T) {
+			req, err := constructByIdQuery("test", model, nil)
+			assert.Nil(t, err)
+			assert.NotContains(t, req.Query(), "whitelistPools")
+
+			req, err = constructListQuery(model, nil)
+			assert.Nil(t, err)
+			assert.NotContains(t, req.Query(), "whitelistPools")
+		})
+	}
+
+	t.Run("when whitelistPools is selected explicitly", func(t *testing.T) {
+		req, err := constructByIdQuery("test", TokenFields, &RequestOptions{
+			IncludeFields: []string{"id", "whitelistPools.id"},
+			Derived:       map[string]DerivedOptions{"whitelistPools": {First: 10}},
+		})
+		assert.Nil(t, err)
+		assert.Contains(t, req.Query(), "whitelistPools")
+	})
+}
+
+func TestValidateWhere(t *testing.T) {
 	tests := map[string]struct {
-		model modelFields
-		field string
-		want  bool
+		model      modelFields
+		where      Where
+		wantErr    bool
+		wantErrMsg string
 	}{
-		"when field is in model fields": {
-			model: PoolFields,
-			field: "sqrtPrice",
-			want:  true,
+		"when where is empty": {
+			model: SwapFields,
+			where: Where{},
 		},
-		"when field is not in model fields": {
+		"when direct field equality": {
+			model: SwapFields,
+			where: Where{"origin": "0x0"},
+		},
+		"when reference field equality": {
+			model: SwapFields,
+			where: Where{"pool": "0x0"},
+		},
+		"when operators are valid": {
+			model: SwapFields,
+			where: Where{
+				"timestamp_gte":              "1",
+				"timestamp_lt":               "2",
+				"amountUSD_not":              "0",
+				"origin_not_in":              []string{"0x0"},
+				"token0_in":                  []string{"0x1", "0x2"},
+				"sender_not_contains_nocase": "ab",
+			},
+		},
+		"when string operators are valid": {
+			model: TokenFields,
+			where: Where{
+				"symbol_starts_with_nocase": "w",
+				"name_ends_with":            "Ether",
+				"name_contains":             "Wrapped",
+			},
+		},
+		"when field is not found": {
+			model:      SwapFields,
+			where:      Where{"notFound": "0x0"},
+			wantErr:    true,
+			wantErrMsg: "unrecognized field given in opts.Where (notFound)",
+		},
+		"when operator is not found": {
+			model:      SwapFields,
+			where:      Where{"timestamp_between": "0x0"},
+			wantErr:    true,
+			wantErrMsg: "unrecognized field given in opts.Where (timestamp_between)",
+		},
+		"when nested reference filter is valid": {
+			model: SwapFields,
+			where: Where{"token0_": map[string]any{"symbol": "WETH"}},
+		},
+		"when logical filters are valid": {
+			model: SwapFields,
+			where: Where{
+				"or": []any{
+					map[string]any{"token0_": Where{"symbol": "WETH"}},
+					Where{"token1_": Where{"symbol": "WETH"}},
+				},
+				"and": []Where{{"amountUSD_gt": "1000"}},
+			},
+		},
+		"when nested filter is on a derived field": {
 			model: PoolFields,
-			field: "poolCount",
-			want:  false,
+			where: Where{"swaps_": Where{"amountUSD_gt": "1000"}},
+		},
+		"when nested filter is on a direct field": {
+			model:      SwapFields,
+			where:      Where{"origin_": Where{"id": "0x0"}},
+			wantErr:    true,
+			wantErrMsg: "unrecognized reference field given in opts.Where (origin_)",
+		},
+		"when nested filter is not a filter": {
+			model:      SwapFields,
+			where:      Where{"pool_": "0x0"},
+			wantErr:    true,
+			wantErrMsg: "must be a filter",
+		},
+		"when nested filter field doesn't exist on the referenced model": {
+			model:      SwapFields,
+			where:      Where{"token0_": Where{"feeTier": "500"}},
+			wantErr:    true,
+			wantErrMsg: "unrecognized field given in opts.Where (feeTier)",
+		},
+		"when logical filter is not a list": {
+			model:      SwapFields,
+			where:      Where{"and": Where{"pool": "0x0"}},
+			wantErr:    true,
+			wantErrMsg: "must be a list of filters",
+		},
+		"when logical filter has an invalid entry": {
+			model:      SwapFields,
+			where:      Where{"or": []Where{{"pool": "0x0"}, {"notFound": "0x0"}}},
+			wantErr:    true,
+			wantErrMsg: "unrecognized field given in opts.Where (notFound)",
+		},
+		"when list operator is not given a list": {
+			model:      SwapFields,
+			where:      Where{"origin_in": "0x0"},
+			wantErr:    true,
+			wantErrMsg: "must be a list",
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := validateField(test.model, test.field)
+			err := validateWhere(test.model, test.where)
+
+			if test.wantErr {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), test.wantErrMsg)
+			} else {
+				assert.Nil(t, err)
+			}
+		})
+	}
+}
+
+func TestFilterTypeName(t *testing.T) {
+	tests := map[string]struct {
+		name string
+		want string
+	}{
+		"normal case": {
+			name: "pool",
+			want: "Pool_filter",
+		},
+		"camel case": {
+			name: "uniswapDayData",
+			want: "UniswapDayData_filter",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := filterTypeName(test.name)
 
 			assert.Equal(t, test.want, got)
 		})
 	}
 }
 
-func TestCutPrefix(t *testing.T) {
+func TestValidateField(t *testing.T) {
 	tests := map[string]struct {
-		s      string
-		prefix string
-		want   string
+		model modelFields
+		field string
+		want  bool
 	}{
-		"when prefix is in s": {
-			s:      "token0.id",
-			prefix: "token0.",
-			want:   "id",
+		"when field is in model fields": {
+			model: PoolFields,
+			field: "sqrtPrice",
+			want:  true,
 		},
-		"when prefix is not in s": {
-			s:      "hello world",
-			prefix: "token0.",
-			want:   "hello world",
+		"when field is not in model fields": {
+			model: PoolFields,
+			field: "poolCount",
+			want:  false,
 		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			got := cutPrefix(test.s, test.prefix)
+			got := validateField(test.model, test.field)
 
 			assert.Equal(t, test.want, got)
 		})
@@ -447,6 +925,17 @@ func TestValidateRequestOpts(t *testing.T) {
 			assert.NotNil(t, err)
 			assert.Contains(t, err.Error(), "List query options (First, Skip, OrderBy, OrderDir) should not be provided for ById queries")
 		})
+
+		t.Run("when Where is provided", func(t *testing.T) {
+			opts := &RequestOptions{
+				IncludeFields: []string{"*"},
+				Where:         Where{"id": "test"},
+			}
+			err := validateRequestOpts(ById, opts)
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "Where should not be provided for ById queries")
+		})
 	})
 
 	t.Run("when query type is List", func(t *testing.T) {
@@ -526,6 +1015,17 @@ func TestValidateRequestOpts(t *testing.T) {
 			assert.Contains(t, err.Error(), "First is too large")
 		})
 
+		t.Run("when MaxDepth is negative", func(t *testing.T) {
+			opts := &RequestOptions{
+				IncludeFields: []string{"*"},
+				MaxDepth:      -1,
+			}
+			err := validateRequestOpts(List, opts)
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), "MaxDepth must not be negative")
+		})
+
 		t.Run("when OrderDir is invalid", func(t *testing.T) {
 			opts := &RequestOptions{
 				IncludeFields: []string{"*"},
@@ -539,6 +1039,56 @@ func TestValidateRequestOpts(t *testing.T) {
 	})
 }
 
+func TestValidateBlockOpts(t *testing.T) {
+	hash := "0x" + strings.Repeat("ab", 32)
+
+	tests := map[string]struct {
+		opts    *RequestOptions
+		wantErr string
+	}{
+		"no block selector": {
+			opts: &RequestOptions{},
+		},
+		"block number": {
+			opts: &RequestOptions{Block: 100},
+		},
+		"block hash": {
+			opts: &RequestOptions{BlockHash: hash},
+		},
+		"block number gte": {
+			opts: &RequestOptions{BlockNumberGte: 100},
+		},
+		"block number and hash": {
+			opts:    &RequestOptions{Block: 100, BlockHash: hash},
+			wantErr: "only one of Block, BlockHash and BlockNumberGte",
+		},
+		"block hash and number gte": {
+			opts:    &RequestOptions{BlockHash: hash, BlockNumberGte: 100},
+			wantErr: "only one of Block, BlockHash and BlockNumberGte",
+		},
+		"malformed block hash": {
+			opts:    &RequestOptions{BlockHash: "0x1234\"}"},
+			wantErr: "BlockHash must be a 0x-prefixed 32 byte hex string",
+		},
+		"negative block number gte": {
+			opts:    &RequestOptions{BlockNumberGte: -1},
+			wantErr: "must not be negative",
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := validateBlockOpts(tc.opts)
+			if tc.wantErr == "" {
+				assert.Nil(t, err)
+			} else {
+				assert.NotNil(t, err)
+				assert.Contains(t, err.Error(), tc.wantErr)
+			}
+		})
+	}
+}
+
 func TestPluralizeModelName(t *testing.T) {
 	tests := map[string]struct {
 		name string
@@ -0,0 +1,149 @@
+package unigraphclient
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+)
+
+// limits on the requests sent to an endpoint
+type RateLimit struct {
+	RequestsPerSecond float64 // sustained request rate (token bucket refill rate). 0 or less means no rate limit.
+	Burst             int     // maximum number of requests sent at once after idling (token bucket size). defaults to 1.
+	MaxInFlight       int     // maximum number of concurrent requests. 0 or less means unlimited.
+}
+
+// limiter enforces a RateLimit with a token bucket and a semaphore
+type limiter struct {
+	rate  float64
+	burst float64
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+
+	inFlight chan struct{} // nil when MaxInFlight is unlimited
+}
+
+// identifies the clients sharing a limiter: those of the same endpoint url with the same limits
+type limiterKey struct {
+	url   string
+	limit RateLimit
+}
+
+// limiters shared by all clients in the process, keyed by endpoint url and limits
+var sharedLimiters = struct {
+	sync.Mutex
+	m map[limiterKey]*limiter
+}{m: map[limiterKey]*limiter{}}
+
+// returns the limiter shared by every client of url with the same limits, creating it if needed. clients of the same
+// url with different limits get their own limiters, so each client's limits are always enforced.
+func sharedLimiter(url string, limit RateLimit) *limiter {
+	if limit.Burst < 1 {
+		limit.Burst = 1 // the default, so it shares a limiter with an explicit Burst of 1
+	}
+	key := limiterKey{url: url, limit: limit}
+
+	sharedLimiters.Lock()
+	defer sharedLimiters.Unlock()
+	if l, ok := sharedLimiters.m[key]; ok {
+		return l
+	}
+	l := newLimiter(limit)
+	sharedLimiters.m[key] = l
+	return l
+}
+
+func newLimiter(limit RateLimit) *limiter {
+	burst := float64(limit.Burst)
+	if burst < 1 {
+		burst = 1
+	}
+	l := &limiter{
+		rate:   limit.RequestsPerSecond,
+		burst:  burst,
+		tokens: burst,
+		last:   time.Now(),
+	}
+	if limit.MaxInFlight > 0 {
+		l.inFlight = make(chan struct{}, limit.MaxInFlight)
+	}
+	return l
+}
+
+// waits for an in-flight slot and a token, returning a func that releases the slot.
+// returns ctx.Err() if the context is done before the request may be sent.
+func (l *limiter) acquire(ctx context.Context) (func(), error) {
+	release := func() {}
+	if l.inFlight != nil {
+		select {
+		case l.inFlight <- struct{}{}:
+			release = func() { <-l.inFlight }
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+
+	if err := l.wait(ctx); err != nil {
+		release()
+		return nil, err
+	}
+	return release, nil
+}
+
+// context key of the logger of the request being sent
+type requestLoggerKey struct{}
+
+// waits for the limiter before each attempt of a request (see graphql.WithAttemptHook), so retries are rate limited
+// too
+func (l *limiter) beforeAttempt(ctx context.Context) (func(), error) {
+	logger, ok := ctx.Value(requestLoggerKey{}).(*slog.Logger)
+	if !ok {
+		logger = discardLogger
+	}
+
+	waitStart := time.Now()
+	release, err := l.acquire(ctx)
+	if err != nil {
+		logger.Warn("subgraph request not sent: rate limit wait cancelled", slog.Any("error", err))
+		return nil, err
+	}
+	if wait := time.Since(waitStart); wait > time.Millisecond {
+		logger.Debug("subgraph request rate limited", slog.Duration("wait", wait))
+	}
+	return release, nil
+}
+
+// reserves a token from the bucket, sleeping until it is available
+func (l *limiter) wait(ctx context.Context) error {
+	if l.rate <= 0 {
+		return nil
+	}
+
+	l.mu.Lock()
+	now := time.Now()
+	l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	l.last = now
+	l.tokens--
+	delay := time.Duration(-l.tokens / l.rate * float64(time.Second))
+	l.mu.Unlock()
+
+	if delay <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// give back the reservation so waiting callers aren't delayed by a request that was never sent
+		l.mu.Lock()
+		l.tokens++
+		l.mu.Unlock()
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,143 @@
+package unigraphclient
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimit(t *testing.T) {
+	t.Run("when requests exceed the rate", func(t *testing.T) {
+		server := getTestServer(t, SuccessList, "factory")
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{RateLimit: &RateLimit{RequestsPerSecond: 20, Burst: 2}})
+
+		start := time.Now()
+		for i := 0; i < 6; i++ {
+			_, err := client.ListFactories(context.Background(), nil)
+			assert.Nil(t, err)
+		}
+
+		// 2 requests are sent immediately from the burst, the remaining 4 at 20 per second
+		assert.GreaterOrEqual(t, time.Since(start), 190*time.Millisecond)
+	})
+
+	t.Run("when MaxInFlight is set", func(t *testing.T) {
+		var inFlight, maxSeen int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				seen := atomic.LoadInt32(&maxSeen)
+				if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+					break
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+			io.WriteString(w, `{"data": {"factories": []}}`)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{RateLimit: &RateLimit{MaxInFlight: 2}})
+
+		var wg sync.WaitGroup
+		for i := 0; i < 8; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := client.ListFactories(context.Background(), nil)
+				assert.Nil(t, err)
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, int32(2), maxSeen)
+	})
+
+	t.Run("when clients share an endpoint", func(t *testing.T) {
+		limit := &RateLimit{RequestsPerSecond: 1, MaxInFlight: 3}
+		a := NewClient("shared-endpoint", &ClientOptions{RateLimit: limit})
+		b := NewClient("shared-endpoint", &ClientOptions{RateLimit: limit})
+		c := NewClient("other-endpoint", &ClientOptions{RateLimit: limit})
+
+		assert.Same(t, a.endpoints[0].limiter, b.endpoints[0].limiter)
+		assert.NotSame(t, a.endpoints[0].limiter, c.endpoints[0].limiter)
+
+		// a client with different limits for the same endpoint isn't limited by the others' limiter
+		d := NewClient("shared-endpoint", &ClientOptions{RateLimit: &RateLimit{RequestsPerSecond: 100}})
+		assert.NotSame(t, a.endpoints[0].limiter, d.endpoints[0].limiter)
+		assert.Equal(t, float64(100), d.endpoints[0].limiter.rate)
+
+		e := NewClient("shared-endpoint", &ClientOptions{RateLimit: &RateLimit{RequestsPerSecond: 100, Burst: 1}})
+		assert.Same(t, d.endpoints[0].limiter, e.endpoints[0].limiter)
+	})
+
+	t.Run("when a request is retried", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusTooManyRequests)
+				io.WriteString(w, `{"message":"rate limited"}`)
+				return
+			}
+			io.WriteString(w, `{"data": {"factories": []}}`)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{
+			RateLimit:   &RateLimit{RequestsPerSecond: 10},
+			RetryPolicy: &graphql.RetryPolicy{MaxAttempts: 2, InitialBackoff: time.Millisecond},
+		})
+
+		start := time.Now()
+		_, err := client.ListFactories(context.Background(), nil)
+		assert.Nil(t, err)
+		assert.Equal(t, int32(2), calls)
+
+		// the retry waits for a token like any other request
+		assert.GreaterOrEqual(t, time.Since(start), 90*time.Millisecond)
+	})
+
+	t.Run("when the context is cancelled while waiting", func(t *testing.T) {
+		var calls int32
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			atomic.AddInt32(&calls, 1)
+			io.WriteString(w, `{"data": {"factories": []}}`)
+		}))
+		defer server.Close()
+
+		client := NewClient(server.URL, &ClientOptions{RateLimit: &RateLimit{RequestsPerSecond: 0.5}})
+
+		_, err := client.ListFactories(context.Background(), nil)
+		assert.Nil(t, err)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		start := time.Now()
+		_, err = client.ListFactories(ctx, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+		assert.Equal(t, int32(1), calls)
+	})
+}
+
+func TestLimiterWait(t *testing.T) {
+	t.Run("when a cancelled wait returns its token", func(t *testing.T) {
+		l := newLimiter(RateLimit{RequestsPerSecond: 1})
+		assert.Nil(t, l.wait(context.Background()))
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		assert.ErrorIs(t, l.wait(ctx), context.Canceled)
+		assert.InDelta(t, 0, l.tokens, 0.1)
+	})
+}
@@ -58,3 +58,31 @@ func TestPagination(t *testing.T) {
 	assert.Equal(t, 100, len(resp3.Pools))
 	assert.NotEqual(t, resp2.Pools[0].ID, resp3.Pools[0].ID)
 }
+
+func TestIterPagination(t *testing.T) {
+	var (
+		endpoint string = unigraphclient.Endpoints[unigraphclient.Ethereum]
+		pageSize int    = 100
+	)
+
+	client := unigraphclient.NewClient(endpoint, nil)
+
+	reqOpts := &unigraphclient.RequestOptions{
+		IncludeFields: []string{
+			"id",
+		},
+		First: pageSize,
+	}
+
+	// read three pages worth of pools
+	ids := map[string]bool{}
+	for pool, err := range client.IterPools(context.Background(), reqOpts) {
+		assert.Nil(t, err)
+		ids[pool.ID] = true
+		if len(ids) == pageSize*3 {
+			break
+		}
+	}
+
+	assert.Equal(t, pageSize*3, len(ids))
+}
@@ -0,0 +1,70 @@
+package typed
+
+import (
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/converter"
+)
+
+var (
+	bigIntType  = reflect.TypeOf(&big.Int{})
+	decimalType = reflect.TypeOf(&converter.Decimal{})
+	timeType    = reflect.TypeOf(time.Time{})
+	int64Type   = reflect.TypeOf(int64(0))
+)
+
+// DecodeHook is a mapstructure decode hook converting the string and number scalars returned by the subgraph
+// into the field types of the typed models. values for any other field types are passed through unchanged.
+func DecodeHook(from reflect.Type, to reflect.Type, data any) (any, error) {
+	if data == nil {
+		return data, nil
+	}
+	switch to {
+	case bigIntType:
+		s := scalarString(data)
+		b, ok := new(big.Int).SetString(s, 10)
+		if !ok {
+			return nil, fmt.Errorf("typed decode error: unable to convert %q to big int", s)
+		}
+		return b, nil
+	case decimalType:
+		// BigDecimal values can have more digits than a big.Float of any fixed precision holds, so they're
+		// decoded into exact decimals
+		s := scalarString(data)
+		d, err := converter.StringToDecimal(s)
+		if err != nil {
+			return nil, fmt.Errorf("typed decode error: unable to convert %q to decimal", s)
+		}
+		return &d, nil
+	case int64Type:
+		s := scalarString(data)
+		i, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("typed decode error: unable to convert %q to int64", s)
+		}
+		return i, nil
+	case timeType:
+		s := scalarString(data)
+		unix, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("typed decode error: unable to convert %q to timestamp", s)
+		}
+		return time.Unix(unix, 0).UTC(), nil
+	}
+	return data, nil
+}
+
+// formats a decoded json scalar (string or number) as a string
+func scalarString(data any) string {
+	switch v := data.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	}
+	return fmt.Sprint(data)
+}
@@ -0,0 +1,105 @@
+package typed
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/mitchellh/mapstructure"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecodeHook(t *testing.T) {
+	decode := func(input any, result any) error {
+		decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+			DecodeHook: DecodeHook,
+			Result:     result,
+		})
+		if err != nil {
+			return err
+		}
+		return decoder.Decode(input)
+	}
+
+	t.Run("when values are valid", func(t *testing.T) {
+		input := map[string]any{
+			"id":                 "0x0",
+			"createdAtTimestamp": "1620250931",
+			"liquidity":          "303015134493562686441",
+			"token0Price":        "2.5",
+			"volumeUSD":          "175360787979.8419091840895098379928",
+			"tick":               "-201234",
+			"token0": map[string]any{
+				"decimals": "18",
+			},
+			"token1": map[string]any{
+				"decimals": "0",
+			},
+		}
+		var pool Pool
+		err := decode(input, &pool)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "0x0", pool.ID)
+		assert.Equal(t, time.Unix(1620250931, 0).UTC(), pool.CreatedAtTimestamp)
+		expectedLiquidity, _ := new(big.Int).SetString("303015134493562686441", 10)
+		assert.Equal(t, expectedLiquidity, pool.Liquidity)
+		assert.Equal(t, "2.5", pool.Token0Price.String())
+		assert.Equal(t, "175360787979.8419091840895098379928", pool.VolumeUSD.String())
+		assert.Equal(t, int64(-201234), pool.Tick)
+		assert.Equal(t, int64(18), *pool.Token0.Decimals)
+		assert.Equal(t, int64(0), *pool.Token1.Decimals)
+		assert.Nil(t, pool.Token1Price)
+	})
+
+	t.Run("when Int timestamps are returned as numbers", func(t *testing.T) {
+		var dayData PoolDayData
+		err := decode(map[string]any{"date": float64(1620172800)}, &dayData)
+
+		assert.Nil(t, err)
+		assert.Equal(t, time.Unix(1620172800, 0).UTC(), dayData.Date)
+	})
+
+	t.Run("when values are null", func(t *testing.T) {
+		var pool Pool
+		err := decode(map[string]any{"tick": nil, "liquidity": nil, "token0": map[string]any{"decimals": nil}}, &pool)
+
+		assert.Nil(t, err)
+		assert.Equal(t, int64(0), pool.Tick)
+		assert.Nil(t, pool.Liquidity)
+		assert.Nil(t, pool.Token0.Decimals)
+		assert.Nil(t, pool.Token1.Decimals)
+	})
+
+	tests := map[string]struct {
+		input      map[string]any
+		wantErrMsg string
+	}{
+		"when big int is invalid": {
+			input:      map[string]any{"liquidity": "not a number"},
+			wantErrMsg: "unable to convert \"not a number\" to big int",
+		},
+		"when a decimal is invalid": {
+			input:      map[string]any{"volumeUSD": "not a number"},
+			wantErrMsg: "unable to convert \"not a number\" to decimal",
+		},
+		"when int64 is invalid": {
+			input:      map[string]any{"tick": "1.5"},
+			wantErrMsg: "unable to convert \"1.5\" to int64",
+		},
+		"when timestamp is invalid": {
+			input:      map[string]any{"createdAtTimestamp": "yesterday"},
+			wantErrMsg: "unable to convert \"yesterday\" to timestamp",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var pool Pool
+			err := decode(test.input, &pool)
+
+			assert.NotNil(t, err)
+			assert.Contains(t, err.Error(), test.wantErrMsg)
+		})
+	}
+}
@@ -0,0 +1,557 @@
+// Code generated by modelgen from schema.graphql. DO NOT EDIT.
+
+package typed
+
+import (
+	"math/big"
+	"time"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/converter"
+)
+
+// typed variants of the uniswap models defined in the root package. BigInt fields are decoded into *big.Int
+// (or int64 for ticks, block numbers and log indexes, and *int64 for decimals), BigDecimal fields into exact
+// *converter.Decimal values, and timestamps into time.Time.
+
+// type constraint for the typed responses, used by executeRequestAndConvert in the root package
+type Response interface {
+	FactoryResponse | ListFactoriesResponse |
+		PoolResponse | ListPoolsResponse |
+		TokenResponse | ListTokensResponse |
+		BundleResponse | ListBundlesResponse |
+		TickResponse | ListTicksResponse |
+		PositionResponse | ListPositionsResponse |
+		PositionSnapshotResponse | ListPositionSnapshotsResponse |
+		TransactionResponse | ListTransactionsResponse |
+		MintResponse | ListMintsResponse |
+		BurnResponse | ListBurnsResponse |
+		SwapResponse | ListSwapsResponse |
+		CollectResponse | ListCollectsResponse |
+		FlashResponse | ListFlashesResponse |
+		UniswapDayDataResponse | ListUniswapDayDatasResponse |
+		PoolDayDataResponse | ListPoolDayDatasResponse |
+		PoolHourDataResponse | ListPoolHourDatasResponse |
+		TickHourDataResponse | ListTickHourDatasResponse |
+		TickDayDataResponse | ListTickDayDatasResponse |
+		TokenDayDataResponse | ListTokenDayDatasResponse |
+		TokenHourDataResponse | ListTokenHourDatasResponse
+}
+
+type FactoryResponse struct {
+	Factory Factory
+}
+
+type ListFactoriesResponse struct {
+	Factories []Factory
+}
+
+type Factory struct {
+	ID                           string             `json:"id"`
+	PoolCount                    *big.Int           `json:"poolCount"`
+	TxCount                      *big.Int           `json:"txCount"`
+	TotalVolumeUSD               *converter.Decimal `json:"totalVolumeUSD"`
+	TotalVolumeETH               *converter.Decimal `json:"totalVolumeETH"`
+	TotalFeesUSD                 *converter.Decimal `json:"totalFeesUSD"`
+	TotalFeesETH                 *converter.Decimal `json:"totalFeesETH"`
+	UntrackedVolumeUSD           *converter.Decimal `json:"untrackedVolumeUSD"`
+	TotalValueLockedUSD          *converter.Decimal `json:"totalValueLockedUSD"`
+	TotalValueLockedETH          *converter.Decimal `json:"totalValueLockedETH"`
+	TotalValueLockedUSDUntracked *converter.Decimal `json:"totalValueLockedUSDUntracked"`
+	TotalValueLockedETHUntracked *converter.Decimal `json:"totalValueLockedETHUntracked"`
+	Owner                        string             `json:"owner"`
+}
+
+type PoolResponse struct {
+	Pool Pool
+}
+
+type ListPoolsResponse struct {
+	Pools []Pool
+}
+
+type Pool struct {
+	ID                           string             `json:"id"`
+	CreatedAtTimestamp           time.Time          `json:"createdAtTimestamp"`
+	CreatedAtBlockNumber         int64              `json:"createdAtBlockNumber"`
+	Token0                       Token              `json:"token0"`
+	Token1                       Token              `json:"token1"`
+	FeeTier                      *big.Int           `json:"feeTier"`
+	Liquidity                    *big.Int           `json:"liquidity"`
+	SqrtPrice                    *big.Int           `json:"sqrtPrice"`
+	FeeGrowthGlobal0X128         *big.Int           `json:"feeGrowthGlobal0X128"`
+	FeeGrowthGlobal1X128         *big.Int           `json:"feeGrowthGlobal1X128"`
+	Token0Price                  *converter.Decimal `json:"token0Price"`
+	Token1Price                  *converter.Decimal `json:"token1Price"`
+	Tick                         int64              `json:"tick"`
+	ObservationIndex             int64              `json:"observationIndex"`
+	VolumeToken0                 *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1                 *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD                    *converter.Decimal `json:"volumeUSD"`
+	UntrackedVolumeUSD           *converter.Decimal `json:"untrackedVolumeUSD"`
+	FeesUSD                      *converter.Decimal `json:"feesUSD"`
+	TxCount                      *big.Int           `json:"txCount"`
+	CollectedFeesToken0          *converter.Decimal `json:"collectedFeesToken0"`
+	CollectedFeesToken1          *converter.Decimal `json:"collectedFeesToken1"`
+	CollectedFeesUSD             *converter.Decimal `json:"collectedFeesUSD"`
+	TotalValueLockedToken0       *converter.Decimal `json:"totalValueLockedToken0"`
+	TotalValueLockedToken1       *converter.Decimal `json:"totalValueLockedToken1"`
+	TotalValueLockedETH          *converter.Decimal `json:"totalValueLockedETH"`
+	TotalValueLockedUSD          *converter.Decimal `json:"totalValueLockedUSD"`
+	TotalValueLockedUSDUntracked *converter.Decimal `json:"totalValueLockedUSDUntracked"`
+	LiquidityProviderCount       *big.Int           `json:"liquidityProviderCount"`
+	PoolHourData                 []PoolHourData     `json:"poolHourData,omitempty"`
+	PoolDayData                  []PoolDayData      `json:"poolDayData,omitempty"`
+	Mints                        []Mint             `json:"mints,omitempty"`
+	Burns                        []Burn             `json:"burns,omitempty"`
+	Swaps                        []Swap             `json:"swaps,omitempty"`
+	Collects                     []Collect          `json:"collects,omitempty"`
+	Ticks                        []Tick             `json:"ticks,omitempty"`
+}
+
+type TokenResponse struct {
+	Token Token
+}
+
+type ListTokensResponse struct {
+	Tokens []Token
+}
+
+type Token struct {
+	ID                           string             `json:"id"`
+	Symbol                       string             `json:"symbol"`
+	Name                         string             `json:"name"`
+	Decimals                     *int64             `json:"decimals"`
+	TotalSupply                  *big.Int           `json:"totalSupply"`
+	Volume                       *converter.Decimal `json:"volume"`
+	VolumeUSD                    *converter.Decimal `json:"volumeUSD"`
+	UntrackedVolumeUSD           *converter.Decimal `json:"untrackedVolumeUSD"`
+	FeesUSD                      *converter.Decimal `json:"feesUSD"`
+	TxCount                      *big.Int           `json:"txCount"`
+	PoolCount                    *big.Int           `json:"poolCount"`
+	TotalValueLocked             *converter.Decimal `json:"totalValueLocked"`
+	TotalValueLockedUSD          *converter.Decimal `json:"totalValueLockedUSD"`
+	TotalValueLockedUSDUntracked *converter.Decimal `json:"totalValueLockedUSDUntracked"`
+	DerivedETH                   *converter.Decimal `json:"derivedETH"`
+	WhitelistPools               []Pool             `json:"whitelistPools,omitempty"`
+	TokenDayData                 []TokenDayData     `json:"tokenDayData,omitempty"`
+}
+
+type BundleResponse struct {
+	Bundle Bundle
+}
+
+type ListBundlesResponse struct {
+	Bundles []Bundle
+}
+
+type Bundle struct {
+	ID          string             `json:"id"`
+	EthPriceUSD *converter.Decimal `json:"ethPriceUSD"`
+}
+
+type TickResponse struct {
+	Tick Tick
+}
+
+type ListTicksResponse struct {
+	Ticks []Tick
+}
+
+type Tick struct {
+	ID                     string             `json:"id"`
+	PoolAddress            string             `json:"poolAddress"`
+	TickIdx                int64              `json:"tickIdx"`
+	Pool                   Pool               `json:"pool"`
+	LiquidityGross         *big.Int           `json:"liquidityGross"`
+	LiquidityNet           *big.Int           `json:"liquidityNet"`
+	Price0                 *converter.Decimal `json:"price0"`
+	Price1                 *converter.Decimal `json:"price1"`
+	VolumeToken0           *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1           *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD              *converter.Decimal `json:"volumeUSD"`
+	UntrackedVolumeUSD     *converter.Decimal `json:"untrackedVolumeUSD"`
+	FeesUSD                *converter.Decimal `json:"feesUSD"`
+	CollectedFeesToken0    *converter.Decimal `json:"collectedFeesToken0"`
+	CollectedFeesToken1    *converter.Decimal `json:"collectedFeesToken1"`
+	CollectedFeesUSD       *converter.Decimal `json:"collectedFeesUSD"`
+	CreatedAtTimestamp     time.Time          `json:"createdAtTimestamp"`
+	CreatedAtBlockNumber   int64              `json:"createdAtBlockNumber"`
+	LiquidityProviderCount *big.Int           `json:"liquidityProviderCount"`
+	FeeGrowthOutside0X128  *big.Int           `json:"feeGrowthOutside0X128"`
+	FeeGrowthOutside1X128  *big.Int           `json:"feeGrowthOutside1X128"`
+}
+
+type PositionResponse struct {
+	Position Position
+}
+
+type ListPositionsResponse struct {
+	Positions []Position
+}
+
+type Position struct {
+	ID                       string             `json:"id"`
+	Owner                    string             `json:"owner"`
+	Pool                     Pool               `json:"pool"`
+	Token0                   Token              `json:"token0"`
+	Token1                   Token              `json:"token1"`
+	TickLower                Tick               `json:"tickLower"`
+	TickUpper                Tick               `json:"tickUpper"`
+	Liquidity                *big.Int           `json:"liquidity"`
+	DepositedToken0          *converter.Decimal `json:"depositedToken0"`
+	DepositedToken1          *converter.Decimal `json:"depositedToken1"`
+	WithdrawnToken0          *converter.Decimal `json:"withdrawnToken0"`
+	WithdrawnToken1          *converter.Decimal `json:"withdrawnToken1"`
+	CollectedFeesToken0      *converter.Decimal `json:"collectedFeesToken0"`
+	CollectedFeesToken1      *converter.Decimal `json:"collectedFeesToken1"`
+	Transaction              Transaction        `json:"transaction"`
+	FeeGrowthInside0LastX128 *big.Int           `json:"feeGrowthInside0LastX128"`
+	FeeGrowthInside1LastX128 *big.Int           `json:"feeGrowthInside1LastX128"`
+}
+
+type PositionSnapshotResponse struct {
+	PositionSnapshot PositionSnapshot
+}
+
+type ListPositionSnapshotsResponse struct {
+	PositionSnapshots []PositionSnapshot
+}
+
+type PositionSnapshot struct {
+	ID                       string             `json:"id"`
+	Owner                    string             `json:"owner"`
+	Pool                     Pool               `json:"pool"`
+	Position                 Position           `json:"position"`
+	BlockNumber              int64              `json:"blockNumber"`
+	Timestamp                time.Time          `json:"timestamp"`
+	Liquidity                *big.Int           `json:"liquidity"`
+	DepositedToken0          *converter.Decimal `json:"depositedToken0"`
+	DepositedToken1          *converter.Decimal `json:"depositedToken1"`
+	WithdrawnToken0          *converter.Decimal `json:"withdrawnToken0"`
+	WithdrawnToken1          *converter.Decimal `json:"withdrawnToken1"`
+	CollectedFeesToken0      *converter.Decimal `json:"collectedFeesToken0"`
+	CollectedFeesToken1      *converter.Decimal `json:"collectedFeesToken1"`
+	Transaction              Transaction        `json:"transaction"`
+	FeeGrowthInside0LastX128 *big.Int           `json:"feeGrowthInside0LastX128"`
+	FeeGrowthInside1LastX128 *big.Int           `json:"feeGrowthInside1LastX128"`
+}
+
+type TransactionResponse struct {
+	Transaction Transaction
+}
+
+type ListTransactionsResponse struct {
+	Transactions []Transaction
+}
+
+type Transaction struct {
+	ID          string    `json:"id"`
+	BlockNumber int64     `json:"blockNumber"`
+	Timestamp   time.Time `json:"timestamp"`
+	GasUsed     *big.Int  `json:"gasUsed"`
+	GasPrice    *big.Int  `json:"gasPrice"`
+	Mints       []Mint    `json:"mints,omitempty"`
+	Burns       []Burn    `json:"burns,omitempty"`
+	Swaps       []Swap    `json:"swaps,omitempty"`
+	Flashed     []Flash   `json:"flashed,omitempty"`
+	Collects    []Collect `json:"collects,omitempty"`
+}
+
+type MintResponse struct {
+	Mint Mint
+}
+
+type ListMintsResponse struct {
+	Mints []Mint
+}
+
+type Mint struct {
+	ID          string             `json:"id"`
+	Transaction Transaction        `json:"transaction"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Pool        Pool               `json:"pool"`
+	Token0      Token              `json:"token0"`
+	Token1      Token              `json:"token1"`
+	Owner       string             `json:"owner"`
+	Sender      string             `json:"sender"`
+	Origin      string             `json:"origin"`
+	Amount      *big.Int           `json:"amount"`
+	Amount0     *converter.Decimal `json:"amount0"`
+	Amount1     *converter.Decimal `json:"amount1"`
+	AmountUSD   *converter.Decimal `json:"amountUSD"`
+	TickLower   int64              `json:"tickLower"`
+	TickUpper   int64              `json:"tickUpper"`
+	LogIndex    int64              `json:"logIndex"`
+}
+
+type BurnResponse struct {
+	Burn Burn
+}
+
+type ListBurnsResponse struct {
+	Burns []Burn
+}
+
+type Burn struct {
+	ID          string             `json:"id"`
+	Transaction Transaction        `json:"transaction"`
+	Pool        Pool               `json:"pool"`
+	Token0      Token              `json:"token0"`
+	Token1      Token              `json:"token1"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Owner       string             `json:"owner"`
+	Origin      string             `json:"origin"`
+	Amount      *big.Int           `json:"amount"`
+	Amount0     *converter.Decimal `json:"amount0"`
+	Amount1     *converter.Decimal `json:"amount1"`
+	AmountUSD   *converter.Decimal `json:"amountUSD"`
+	TickLower   int64              `json:"tickLower"`
+	TickUpper   int64              `json:"tickUpper"`
+	LogIndex    int64              `json:"logIndex"`
+}
+
+type SwapResponse struct {
+	Swap Swap
+}
+
+type ListSwapsResponse struct {
+	Swaps []Swap
+}
+
+type Swap struct {
+	ID           string             `json:"id"`
+	Transaction  Transaction        `json:"transaction"`
+	Timestamp    time.Time          `json:"timestamp"`
+	Pool         Pool               `json:"pool"`
+	Token0       Token              `json:"token0"`
+	Token1       Token              `json:"token1"`
+	Sender       string             `json:"sender"`
+	Recipient    string             `json:"recipient"`
+	Origin       string             `json:"origin"`
+	Amount0      *converter.Decimal `json:"amount0"`
+	Amount1      *converter.Decimal `json:"amount1"`
+	AmountUSD    *converter.Decimal `json:"amountUSD"`
+	SqrtPriceX96 *big.Int           `json:"sqrtPriceX96"`
+	Tick         int64              `json:"tick"`
+	LogIndex     int64              `json:"logIndex"`
+}
+
+type CollectResponse struct {
+	Collect Collect
+}
+
+type ListCollectsResponse struct {
+	Collects []Collect
+}
+
+type Collect struct {
+	ID          string             `json:"id"`
+	Transaction Transaction        `json:"transaction"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Pool        Pool               `json:"pool"`
+	Owner       string             `json:"owner"`
+	Amount0     *converter.Decimal `json:"amount0"`
+	Amount1     *converter.Decimal `json:"amount1"`
+	AmountUSD   *converter.Decimal `json:"amountUSD"`
+	TickLower   int64              `json:"tickLower"`
+	TickUpper   int64              `json:"tickUpper"`
+	LogIndex    int64              `json:"logIndex"`
+}
+
+type FlashResponse struct {
+	Flash Flash
+}
+
+type ListFlashesResponse struct {
+	Flashes []Flash
+}
+
+type Flash struct {
+	ID          string             `json:"id"`
+	Transaction Transaction        `json:"transaction"`
+	Timestamp   time.Time          `json:"timestamp"`
+	Pool        Pool               `json:"pool"`
+	Sender      string             `json:"sender"`
+	Recipient   string             `json:"recipient"`
+	Amount0     *converter.Decimal `json:"amount0"`
+	Amount1     *converter.Decimal `json:"amount1"`
+	AmountUSD   *converter.Decimal `json:"amountUSD"`
+	Amount0Paid *converter.Decimal `json:"amount0Paid"`
+	Amount1Paid *converter.Decimal `json:"amount1Paid"`
+	LogIndex    int64              `json:"logIndex"`
+}
+
+type UniswapDayDataResponse struct {
+	UniswapDayData UniswapDayData
+}
+
+type ListUniswapDayDatasResponse struct {
+	UniswapDayDatas []UniswapDayData
+}
+
+type UniswapDayData struct {
+	ID                 string             `json:"id"`
+	Date               time.Time          `json:"date"`
+	VolumeETH          *converter.Decimal `json:"volumeETH"`
+	VolumeUSD          *converter.Decimal `json:"volumeUSD"`
+	VolumeUSDUntracked *converter.Decimal `json:"volumeUSDUntracked"`
+	FeesUSD            *converter.Decimal `json:"feesUSD"`
+	TxCount            *big.Int           `json:"txCount"`
+	TvlUSD             *converter.Decimal `json:"tvlUSD"`
+}
+
+type PoolDayDataResponse struct {
+	PoolDayData PoolDayData
+}
+
+type ListPoolDayDatasResponse struct {
+	PoolDayDatas []PoolDayData
+}
+
+type PoolDayData struct {
+	ID                   string             `json:"id"`
+	Date                 time.Time          `json:"date"`
+	Pool                 Pool               `json:"pool"`
+	Liquidity            *big.Int           `json:"liquidity"`
+	SqrtPrice            *big.Int           `json:"sqrtPrice"`
+	Token0Price          *converter.Decimal `json:"token0Price"`
+	Token1Price          *converter.Decimal `json:"token1Price"`
+	Tick                 int64              `json:"tick"`
+	FeeGrowthGlobal0X128 *big.Int           `json:"feeGrowthGlobal0X128"`
+	FeeGrowthGlobal1X128 *big.Int           `json:"feeGrowthGlobal1X128"`
+	TvlUSD               *converter.Decimal `json:"tvlUSD"`
+	VolumeToken0         *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1         *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD            *converter.Decimal `json:"volumeUSD"`
+	FeesUSD              *converter.Decimal `json:"feesUSD"`
+	TxCount              *big.Int           `json:"txCount"`
+	Open                 *converter.Decimal `json:"open"`
+	High                 *converter.Decimal `json:"high"`
+	Low                  *converter.Decimal `json:"low"`
+	Close                *converter.Decimal `json:"close"`
+}
+
+type PoolHourDataResponse struct {
+	PoolHourData PoolHourData
+}
+
+type ListPoolHourDatasResponse struct {
+	PoolHourDatas []PoolHourData
+}
+
+type PoolHourData struct {
+	ID                   string             `json:"id"`
+	PeriodStartUnix      time.Time          `json:"periodStartUnix"`
+	Pool                 Pool               `json:"pool"`
+	Liquidity            *big.Int           `json:"liquidity"`
+	SqrtPrice            *big.Int           `json:"sqrtPrice"`
+	Token0Price          *converter.Decimal `json:"token0Price"`
+	Token1Price          *converter.Decimal `json:"token1Price"`
+	Tick                 int64              `json:"tick"`
+	FeeGrowthGlobal0X128 *big.Int           `json:"feeGrowthGlobal0X128"`
+	FeeGrowthGlobal1X128 *big.Int           `json:"feeGrowthGlobal1X128"`
+	TvlUSD               *converter.Decimal `json:"tvlUSD"`
+	VolumeToken0         *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1         *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD            *converter.Decimal `json:"volumeUSD"`
+	FeesUSD              *converter.Decimal `json:"feesUSD"`
+	TxCount              *big.Int           `json:"txCount"`
+	Open                 *converter.Decimal `json:"open"`
+	High                 *converter.Decimal `json:"high"`
+	Low                  *converter.Decimal `json:"low"`
+	Close                *converter.Decimal `json:"close"`
+}
+
+type TickHourDataResponse struct {
+	TickHourData TickHourData
+}
+
+type ListTickHourDatasResponse struct {
+	TickHourDatas []TickHourData
+}
+
+type TickHourData struct {
+	ID              string             `json:"id"`
+	PeriodStartUnix time.Time          `json:"periodStartUnix"`
+	Pool            Pool               `json:"pool"`
+	Tick            Tick               `json:"tick"`
+	LiquidityGross  *big.Int           `json:"liquidityGross"`
+	LiquidityNet    *big.Int           `json:"liquidityNet"`
+	VolumeToken0    *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1    *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD       *converter.Decimal `json:"volumeUSD"`
+	FeesUSD         *converter.Decimal `json:"feesUSD"`
+}
+
+type TickDayDataResponse struct {
+	TickDayData TickDayData
+}
+
+type ListTickDayDatasResponse struct {
+	TickDayDatas []TickDayData
+}
+
+type TickDayData struct {
+	ID                    string             `json:"id"`
+	Date                  time.Time          `json:"date"`
+	Pool                  Pool               `json:"pool"`
+	Tick                  Tick               `json:"tick"`
+	LiquidityGross        *big.Int           `json:"liquidityGross"`
+	LiquidityNet          *big.Int           `json:"liquidityNet"`
+	VolumeToken0          *converter.Decimal `json:"volumeToken0"`
+	VolumeToken1          *converter.Decimal `json:"volumeToken1"`
+	VolumeUSD             *converter.Decimal `json:"volumeUSD"`
+	FeesUSD               *converter.Decimal `json:"feesUSD"`
+	FeeGrowthOutside0X128 *big.Int           `json:"feeGrowthOutside0X128"`
+	FeeGrowthOutside1X128 *big.Int           `json:"feeGrowthOutside1X128"`
+}
+
+type TokenDayDataResponse struct {
+	TokenDayData TokenDayData
+}
+
+type ListTokenDayDatasResponse struct {
+	TokenDayDatas []TokenDayData
+}
+
+type TokenDayData struct {
+	ID                  string             `json:"id"`
+	Date                time.Time          `json:"date"`
+	Token               Token              `json:"token"`
+	Volume              *converter.Decimal `json:"volume"`
+	VolumeUSD           *converter.Decimal `json:"volumeUSD"`
+	UntrackedVolumeUSD  *converter.Decimal `json:"untrackedVolumeUSD"`
+	TotalValueLocked    *converter.Decimal `json:"totalValueLocked"`
+	TotalValueLockedUSD *converter.Decimal `json:"totalValueLockedUSD"`
+	PriceUSD            *converter.Decimal `json:"priceUSD"`
+	FeesUSD             *converter.Decimal `json:"feesUSD"`
+	Open                *converter.Decimal `json:"open"`
+	High                *converter.Decimal `json:"high"`
+	Low                 *converter.Decimal `json:"low"`
+	Close               *converter.Decimal `json:"close"`
+}
+
+type TokenHourDataResponse struct {
+	TokenHourData TokenHourData
+}
+
+type ListTokenHourDatasResponse struct {
+	TokenHourDatas []TokenHourData
+}
+
+type TokenHourData struct {
+	ID                  string             `json:"id"`
+	PeriodStartUnix     time.Time          `json:"periodStartUnix"`
+	Token               Token              `json:"token"`
+	Volume              *converter.Decimal `json:"volume"`
+	VolumeUSD           *converter.Decimal `json:"volumeUSD"`
+	UntrackedVolumeUSD  *converter.Decimal `json:"untrackedVolumeUSD"`
+	TotalValueLocked    *converter.Decimal `json:"totalValueLocked"`
+	TotalValueLockedUSD *converter.Decimal `json:"totalValueLockedUSD"`
+	PriceUSD            *converter.Decimal `json:"priceUSD"`
+	FeesUSD             *converter.Decimal `json:"feesUSD"`
+	Open                *converter.Decimal `json:"open"`
+	High                *converter.Decimal `json:"high"`
+	Low                 *converter.Decimal `json:"low"`
+	Close               *converter.Decimal `json:"close"`
+}
@@ -0,0 +1,26 @@
+package typed
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/v3math"
+)
+
+// Price returns the price of token0 in units of token1 (see the v3math package), computed exactly from SqrtPrice.
+// SqrtPrice, Token0.Decimals and Token1.Decimals must be included in the query.
+func (p Pool) Price() (*big.Rat, error) {
+	if p.SqrtPrice == nil || p.Token0.Decimals == nil || p.Token1.Decimals == nil {
+		return nil, errors.New("price error: pool is missing the square root price or token decimals (are they included in the query?)")
+	}
+	return v3math.SqrtPriceX96ToPrice(p.SqrtPrice, int(*p.Token0.Decimals), int(*p.Token1.Decimals))
+}
+
+// Price returns the price of token0 in units of token1 after the swap, computed exactly from SqrtPriceX96.
+// SqrtPriceX96, Token0.Decimals and Token1.Decimals must be included in the query.
+func (s Swap) Price() (*big.Rat, error) {
+	if s.SqrtPriceX96 == nil || s.Token0.Decimals == nil || s.Token1.Decimals == nil {
+		return nil, errors.New("price error: swap is missing the square root price or token decimals (are they included in the query?)")
+	}
+	return v3math.SqrtPriceX96ToPrice(s.SqrtPriceX96, int(*s.Token0.Decimals), int(*s.Token1.Decimals))
+}
@@ -0,0 +1,60 @@
+package typed
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func decimals(d int64) *int64 {
+	return &d
+}
+
+func TestPoolPrice(t *testing.T) {
+	sqrtPrice, _ := new(big.Int).SetString("2018382873588440326581633304624437", 10)
+
+	t.Run("when successful", func(t *testing.T) {
+		pool := Pool{SqrtPrice: sqrtPrice, Token0: Token{Decimals: decimals(6)}, Token1: Token{Decimals: decimals(18)}}
+		price, err := pool.Price()
+		assert.Nil(t, err)
+		assert.Equal(t, "1540.820552", new(big.Rat).Inv(price).FloatString(6))
+	})
+
+	t.Run("when a token has 0 decimals", func(t *testing.T) {
+		pool := Pool{SqrtPrice: sqrtPrice, Token0: Token{Decimals: decimals(0)}, Token1: Token{Decimals: decimals(0)}}
+		price, err := pool.Price()
+		assert.Nil(t, err)
+		assert.Equal(t, "649004842.701", price.FloatString(3))
+	})
+
+	t.Run("when sqrtPrice is not included in the query", func(t *testing.T) {
+		_, err := Pool{Token0: Token{Decimals: decimals(6)}, Token1: Token{Decimals: decimals(18)}}.Price()
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when decimals are not included in the query", func(t *testing.T) {
+		_, err := Pool{SqrtPrice: sqrtPrice, Token0: Token{Decimals: decimals(6)}}.Price()
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "token decimals")
+	})
+}
+
+func TestSwapPrice(t *testing.T) {
+	sqrtPriceX96, _ := new(big.Int).SetString("79228162514264337593543950336", 10)
+
+	t.Run("when successful", func(t *testing.T) {
+		swap := Swap{SqrtPriceX96: sqrtPriceX96, Token0: Token{Decimals: decimals(18)}, Token1: Token{Decimals: decimals(6)}}
+		price, err := swap.Price()
+		assert.Nil(t, err)
+		assert.Equal(t, "1000000000000", price.FloatString(0))
+	})
+
+	t.Run("when fields are not included in the query", func(t *testing.T) {
+		_, err := Swap{}.Price()
+		assert.NotNil(t, err)
+
+		_, err = Swap{SqrtPriceX96: sqrtPriceX96}.Price()
+		assert.NotNil(t, err)
+	})
+}
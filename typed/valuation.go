@@ -0,0 +1,38 @@
+package typed
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/v3math"
+)
+
+// Value returns the tokens held by the position, whether it is in range, and their value in USD (see
+// v3math.ValuePosition). token prices are Token.DerivedETH * Bundle.EthPriceUSD. the position must include the fields
+// in unigraphclient.PositionValueFields, and the bundle ethPriceUSD.
+func (p Position) Value(bundle Bundle) (*v3math.PositionValue, error) {
+	switch {
+	case p.Liquidity == nil:
+		return nil, errors.New("position value error: position is missing liquidity (is it included in the query?)")
+	case p.Pool.SqrtPrice == nil:
+		return nil, errors.New("position value error: position is missing pool.sqrtPrice (is it included in the query?)")
+	case p.Token0.Decimals == nil || p.Token1.Decimals == nil:
+		return nil, errors.New("position value error: position is missing token0.decimals or token1.decimals (are they included in the query?)")
+	case p.Token0.DerivedETH == nil || p.Token1.DerivedETH == nil:
+		return nil, errors.New("position value error: position is missing token0.derivedETH or token1.derivedETH (are they included in the query?)")
+	case bundle.EthPriceUSD == nil:
+		return nil, errors.New("position value error: bundle is missing ethPriceUSD (is it included in the query?)")
+	}
+
+	return v3math.ValuePosition(v3math.Position{
+		Liquidity:    p.Liquidity,
+		TickLower:    int(p.TickLower.TickIdx),
+		TickUpper:    int(p.TickUpper.TickIdx),
+		SqrtPriceX96: p.Pool.SqrtPrice,
+		Tick:         int(p.Pool.Tick),
+		Decimals0:    int(*p.Token0.Decimals),
+		Decimals1:    int(*p.Token1.Decimals),
+		Price0USD:    new(big.Rat).Mul(p.Token0.DerivedETH.Rat(), bundle.EthPriceUSD.Rat()),
+		Price1USD:    new(big.Rat).Mul(p.Token1.DerivedETH.Rat(), bundle.EthPriceUSD.Rat()),
+	})
+}
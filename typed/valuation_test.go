@@ -0,0 +1,63 @@
+package typed
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/converter"
+	"github.com/stretchr/testify/assert"
+)
+
+func decimal(s string) *converter.Decimal {
+	d, _ := converter.StringToDecimal(s)
+	return &d
+}
+
+func TestPositionValue(t *testing.T) {
+	sqrtPrice, _ := new(big.Int).SetString("2018382873588440326581633304624437", 10)
+	position := Position{
+		Liquidity: big.NewInt(1e18),
+		TickLower: Tick{TickIdx: 200000},
+		TickUpper: Tick{TickIdx: 205000},
+		Pool:      Pool{SqrtPrice: sqrtPrice, Tick: 202919},
+		Token0:    Token{Decimals: decimals(6), DerivedETH: decimal("0.0005")},
+		Token1:    Token{Decimals: decimals(18), DerivedETH: decimal("1")},
+	}
+	bundle := Bundle{EthPriceUSD: decimal("2000")}
+
+	t.Run("when successful", func(t *testing.T) {
+		value, err := position.Value(bundle)
+		assert.Nil(t, err)
+		assert.True(t, value.InRange)
+		assert.Equal(t, "3877662.144086", value.Amount0.FloatString(6))
+		assert.Equal(t, "10797896.95", value.ValueUSD.FloatString(2))
+	})
+
+	t.Run("when fields are not included in the query", func(t *testing.T) {
+		_, err := Position{}.Value(bundle)
+		assert.NotNil(t, err)
+
+		p := position
+		p.Token1.DerivedETH = nil
+		_, err = p.Value(bundle)
+		assert.NotNil(t, err)
+
+		_, err = position.Value(Bundle{})
+		assert.NotNil(t, err)
+	})
+
+	t.Run("when decimals are not included in the query", func(t *testing.T) {
+		p := position
+		p.Token1.Decimals = nil
+		_, err := p.Value(bundle)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "decimals")
+	})
+
+	t.Run("when decimals are invalid", func(t *testing.T) {
+		p := position
+		p.Token1.Decimals = decimals(-1)
+		_, err := p.Value(bundle)
+		assert.NotNil(t, err)
+	})
+}
@@ -1,9 +1,13 @@
 package unigraphclient
 
 import (
+	"context"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/emersonmacro/go-uniswap-subgraph-client/graphql"
+	"github.com/emersonmacro/go-uniswap-subgraph-client/typed"
 )
 
 // Uniswap model types are defined in models.go
@@ -11,54 +15,77 @@ import (
 // main uniswap subgraph client
 type Client struct {
 	hostUrl   string
-	GqlClient *graphql.Client
+	GqlClient *graphql.Client // graphql client of the primary (first) endpoint
+	logger    *slog.Logger
+	endpoints []*endpoint // endpoints in priority order. more than one when created with NewFailoverClient.
+	health    *healthState
+	chainHead func(ctx context.Context) (int, error)
+	cache     *responseCache // nil when caching is disabled
 }
 
 // options when creating a new Client
 type ClientOptions struct {
-	HttpClient *http.Client
-	CloseReq   bool
+	HttpClient   *http.Client
+	CloseReq     bool
+	RetryPolicy  *graphql.RetryPolicy                   // retries failed requests (transport errors, 429/502/503/504, transient indexer errors). nil disables retries.
+	RateLimit    *RateLimit                             // client-side rate limit and concurrency cap, applied to every attempt. shared by all clients of the same url with the same limits. nil disables limiting.
+	Failover     *FailoverOptions                       // health checking and failover between endpoints. only used by NewFailoverClient.
+	ChainHead    func(ctx context.Context) (int, error) // returns the latest block number of the chain (e.g. from an rpc node). required by RequestOptions.MaxBlockLag.
+	Cache        Cache                                  // caches responses (e.g. NewMemoryCache or NewDiskCache). responses pinned with Block or BlockHash are cached indefinitely. nil disables caching.
+	CacheOptions *CacheOptions                          // expiry of cached responses for the latest block. when nil, only pinned responses are cached.
+	Logger       *slog.Logger                           // structured logger for requests (debug: request details, info: completed requests, error: failed requests). nil disables logging.
 }
 
 // options when creating a new Request
 type RequestOptions struct {
-	IncludeFields []string // fields to include in the query. '*' is a valid option meaning 'include all fields'. if any fields are listed in IncludeFields besides '*', ExcludeFields must be empty.
-	ExcludeFields []string // fields to exclude from the query. only valid when '*' is in IncludeFields.
-	Block         int      // query for data at a specific block number.
-	First         int      // number of results to retrieve. `100` is the default. only valid for List queries.
-	Skip          int      // number of results to skip. `0` is the default. only valid for List queries.
-	OrderBy       string   // field to order by. `id` is the default. only valid for List queries.
-	OrderDir      string   // order direction. `asc` for ascending and `desc` for descending are the only valid options. `asc` is the default. only valid for List queries.
+	IncludeFields    []string                  // fields to include in the query. '*' is a valid option meaning 'include all fields'. if any fields are listed in IncludeFields besides '*', ExcludeFields must be empty.
+	ExcludeFields    []string                  // fields to exclude from the query. only valid when '*' is in IncludeFields.
+	Block            int                       // query for data at a specific block number.
+	BlockHash        string                    // query for data at the block with this hash (reorg-safe). only one of Block, BlockHash and BlockNumberGte can be set.
+	BlockNumberGte   int                       // query for the latest data, failing if the subgraph hasn't indexed up to at least this block number.
+	First            int                       // number of results to retrieve. `100` is the default. only valid for List queries.
+	Skip             int                       // number of results to skip. `0` is the default. only valid for List queries.
+	OrderBy          string                    // field to order by. `id` is the default. only valid for List queries.
+	OrderDir         string                    // order direction. `asc` for ascending and `desc` for descending are the only valid options. `asc` is the default. only valid for List queries.
+	Where            Where                     // filter predicates for the query e.g. {"pool": "0x...", "timestamp_gt": 1700000000}. only valid for List queries.
+	Derived          map[string]DerivedOptions // arguments for derived list fields selected in IncludeFields, keyed by field path e.g. "swaps" or "pool.swaps".
+	MaxBlockLag      int                       // fail with ErrStaleSubgraph if the subgraph has indexed more than MaxBlockLag blocks behind the chain head (from ClientOptions.ChainHead). 0 disables the check.
+	MaxAge           time.Duration             // fail with ErrStaleSubgraph if the latest block indexed by the subgraph is older than MaxAge. 0 disables the check.
+	AllowPartialData bool                      // when the response has both data and errors, return the fields that resolved along with a *PartialDataError instead of discarding the data.
+	MaxDepth         int                       // maximum number of references a field in IncludeFields can traverse e.g. `position.pool.token0.symbol` has a depth of 3. `4` is the default.
+	Schema           *Schema                   // validate fields and filters against a schema loaded from the endpoint (see Client.LoadSchema) instead of the built-in models.
 }
 
-// type constraint for executeRequestAndConvert
-type Response interface {
-	FactoryResponse | ListFactoriesResponse |
-		PoolResponse | ListPoolsResponse |
-		TokenResponse | ListTokensResponse |
-		BundleResponse | ListBundlesResponse |
-		TickResponse | ListTicksResponse |
-		PositionResponse | ListPositionsResponse |
-		PositionSnapshotResponse | ListPositionSnapshotsResponse |
-		TransactionResponse | ListTransactionsResponse |
-		MintResponse | ListMintsResponse |
-		BurnResponse | ListBurnsResponse |
-		SwapResponse | ListSwapsResponse |
-		CollectResponse | ListCollectsResponse |
-		FlashResponse | ListFlashesResponse |
-		UniswapDayDataResponse | ListUniswapDayDatasResponse |
-		PoolDayDataResponse | ListPoolDayDatasResponse |
-		PoolHourDataResponse | ListPoolHourDatasResponse |
-		TickHourDataResponse | ListTickHourDatasResponse |
-		TickDayDataResponse | ListTickDayDatasResponse |
-		TokenDayDataResponse | ListTokenDayDatasResponse |
-		TokenHourDataResponse | ListTokenHourDatasResponse
+// arguments for a derived list field. zero values are omitted from the query, so the graph's defaults apply.
+type DerivedOptions struct {
+	First    int    // number of results to retrieve (<= 1000).
+	Skip     int    // number of results to skip.
+	OrderBy  string // direct field of the derived model to order by.
+	OrderDir string // order direction, `asc` or `desc`.
+	Where    Where  // filter predicates for the derived model.
+}
+
+// filter predicates for List queries, keyed by field name with an optional operator suffix (e.g. `_gt`, `_in`).
+// values are sent as-is, so BigInt and BigDecimal values should generally be given as strings.
+type Where map[string]any
+
+// limits for ListAll* queries. zero values mean no limit.
+type ListAllOptions struct {
+	MaxRows     int // maximum number of rows to collect.
+	MaxRequests int // maximum number of page requests to send (the request pinning the block is not counted).
 }
 
-// intermediate struct used to construct queries
-type fieldRefs struct {
-	directs []string
-	refs    []string
+// summary of the pages fetched by a ListAll* query
+type PageSummary struct {
+	Pages    int  // number of pages fetched.
+	Rows     int  // number of rows collected.
+	Block    int  // block number every page was pinned to (0 when pinned by BlockHash).
+	Complete bool // false if a limit was reached before the last page was fetched.
+}
+
+// type constraint for executeRequestAndConvert
+type Response interface {
+	modelResponse | MetaResponse | typed.Response
 }
 
 // query type enum
@@ -0,0 +1,113 @@
+package v3math
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+// a liquidity position and the current state of its pool, for ValuePosition
+type Position struct {
+	Liquidity    *big.Int // the position's liquidity
+	TickLower    int      // the lower tick of the position's range
+	TickUpper    int      // the upper tick of the position's range
+	SqrtPriceX96 *big.Int // the pool's current square root price
+	Tick         int      // the pool's current tick
+	Decimals0    int      // decimals of token0
+	Decimals1    int      // decimals of token1
+	Price0USD    *big.Rat // USD price of one token0 e.g. token0.derivedETH * bundle.ethPriceUSD. nil leaves the USD values nil.
+	Price1USD    *big.Rat // USD price of one token1
+}
+
+// the tokens held by a position and their value
+type PositionValue struct {
+	Amount0    *big.Rat // amount of token0 held, in whole tokens
+	Amount1    *big.Rat // amount of token1 held, in whole tokens
+	InRange    bool     // whether the pool's current tick is in [TickLower, TickUpper), i.e. the position is earning fees
+	Amount0USD *big.Rat // value of Amount0 in USD
+	Amount1USD *big.Rat // value of Amount1 in USD
+	ValueUSD   *big.Rat // total value of the position in USD
+}
+
+// ValuePosition returns the amounts of token0 and token1 held by a position (excluding uncollected fees), whether it
+// is in range, and its value in USD when both token prices are set
+func ValuePosition(p Position) (*PositionValue, error) {
+	if p.Decimals0 < 0 || p.Decimals1 < 0 {
+		return nil, fmt.Errorf("v3math error: invalid decimals (%d, %d)", p.Decimals0, p.Decimals1)
+	}
+	amount0, amount1, err := AmountsForLiquidity(p.Liquidity, p.SqrtPriceX96, p.Tick, p.TickLower, p.TickUpper)
+	if err != nil {
+		return nil, err
+	}
+
+	value := &PositionValue{
+		Amount0: new(big.Rat).SetFrac(amount0, pow10(p.Decimals0)),
+		Amount1: new(big.Rat).SetFrac(amount1, pow10(p.Decimals1)),
+		InRange: InRange(p.Tick, p.TickLower, p.TickUpper),
+	}
+	if p.Price0USD != nil && p.Price1USD != nil {
+		value.Amount0USD = new(big.Rat).Mul(value.Amount0, p.Price0USD)
+		value.Amount1USD = new(big.Rat).Mul(value.Amount1, p.Price1USD)
+		value.ValueUSD = new(big.Rat).Add(value.Amount0USD, value.Amount1USD)
+	}
+	return value, nil
+}
+
+// InRange reports whether a position with the given tick range is active at the pool's current tick
+func InRange(tick int, tickLower int, tickUpper int) bool {
+	return tickLower <= tick && tick < tickUpper
+}
+
+// AmountsForLiquidity returns the amounts of token0 and token1 (in base units, rounded down) held by liquidity in
+// [tickLower, tickUpper), given the pool's current square root price and tick. below the range the position is all
+// token0, and above it all token1.
+func AmountsForLiquidity(liquidity *big.Int, sqrtPriceX96 *big.Int, tick int, tickLower int, tickUpper int) (*big.Int, *big.Int, error) {
+	if liquidity == nil || liquidity.Sign() < 0 {
+		return nil, nil, fmt.Errorf("v3math error: invalid liquidity %v", liquidity)
+	}
+	if sqrtPriceX96 == nil || sqrtPriceX96.Sign() <= 0 {
+		return nil, nil, fmt.Errorf("v3math error: invalid sqrtPriceX96 %v", sqrtPriceX96)
+	}
+	if tickLower >= tickUpper {
+		return nil, nil, errors.New("v3math error: tickLower must be less than tickUpper")
+	}
+	sqrtPriceLower, err := TickToSqrtPriceX96(tickLower)
+	if err != nil {
+		return nil, nil, err
+	}
+	sqrtPriceUpper, err := TickToSqrtPriceX96(tickUpper)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	switch {
+	case tick < tickLower:
+		return Amount0Delta(sqrtPriceLower, sqrtPriceUpper, liquidity), new(big.Int), nil
+	case tick < tickUpper:
+		return Amount0Delta(sqrtPriceX96, sqrtPriceUpper, liquidity), Amount1Delta(sqrtPriceLower, sqrtPriceX96, liquidity), nil
+	default:
+		return new(big.Int), Amount1Delta(sqrtPriceLower, sqrtPriceUpper, liquidity), nil
+	}
+}
+
+// Amount0Delta returns the amount of token0 (in base units, rounded down) for liquidity between two square root
+// prices, as in SqrtPriceMath.getAmount0Delta: liquidity * 2^96 * (sqrtB - sqrtA) / sqrtB / sqrtA
+func Amount0Delta(sqrtPriceAX96 *big.Int, sqrtPriceBX96 *big.Int, liquidity *big.Int) *big.Int {
+	if sqrtPriceAX96.Cmp(sqrtPriceBX96) > 0 {
+		sqrtPriceAX96, sqrtPriceBX96 = sqrtPriceBX96, sqrtPriceAX96
+	}
+	amount := new(big.Int).Lsh(liquidity, 96)
+	amount.Mul(amount, new(big.Int).Sub(sqrtPriceBX96, sqrtPriceAX96))
+	amount.Quo(amount, sqrtPriceBX96)
+	return amount.Quo(amount, sqrtPriceAX96)
+}
+
+// Amount1Delta returns the amount of token1 (in base units, rounded down) for liquidity between two square root
+// prices, as in SqrtPriceMath.getAmount1Delta: liquidity * (sqrtB - sqrtA) / 2^96
+func Amount1Delta(sqrtPriceAX96 *big.Int, sqrtPriceBX96 *big.Int, liquidity *big.Int) *big.Int {
+	if sqrtPriceAX96.Cmp(sqrtPriceBX96) > 0 {
+		sqrtPriceAX96, sqrtPriceBX96 = sqrtPriceBX96, sqrtPriceAX96
+	}
+	amount := new(big.Int).Mul(liquidity, new(big.Int).Sub(sqrtPriceBX96, sqrtPriceAX96))
+	return amount.Rsh(amount, 96)
+}
@@ -0,0 +1,108 @@
+package v3math
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAmountsForLiquidity(t *testing.T) {
+	liquidity := big.NewInt(1e18)
+
+	tests := map[string]struct {
+		sqrtPriceX96 string
+		tick         int
+		tickLower    int
+		tickUpper    int
+		want0        string
+		want1        string
+		wantErr      bool
+	}{
+		"in range":           {sqrtPriceX96: usdcWethSqrtPriceX96, tick: 202919, tickLower: 200000, tickUpper: 205000, want0: "3877662144086", want1: "3460117403312826577811"},
+		"below range":        {sqrtPriceX96: usdcWethSqrtPriceX96, tick: 202919, tickLower: 203000, tickUpper: 205000, want0: "3720291492261", want1: "0"},
+		"above range":        {sqrtPriceX96: usdcWethSqrtPriceX96, tick: 202919, tickLower: 200000, tickUpper: 202800, want0: "0", want1: "3308168959811659859115"},
+		"full range at 1":    {sqrtPriceX96: "79228162514264337593543950336", tick: 0, tickLower: -887220, tickUpper: 887220, want0: "999999999999999999", want1: "999999999999999999"},
+		"at the upper tick":  {sqrtPriceX96: "79228162514264337593543950336", tick: 0, tickLower: -60, tickUpper: 0, want0: "0", want1: "2995354955910780"},
+		"invalid tick range": {sqrtPriceX96: usdcWethSqrtPriceX96, tick: 202919, tickLower: 205000, tickUpper: 200000, wantErr: true},
+		"tick out of range":  {sqrtPriceX96: usdcWethSqrtPriceX96, tick: 202919, tickLower: MinTick - 1, tickUpper: 0, wantErr: true},
+		"invalid sqrt price": {sqrtPriceX96: "0", tick: 0, tickLower: -60, tickUpper: 60, wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			amount0, amount1, err := AmountsForLiquidity(liquidity, mustInt(t, test.sqrtPriceX96), test.tick, test.tickLower, test.tickUpper)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want0, amount0.String())
+			assert.Equal(t, test.want1, amount1.String())
+		})
+	}
+
+	t.Run("when liquidity is invalid", func(t *testing.T) {
+		_, _, err := AmountsForLiquidity(nil, mustInt(t, usdcWethSqrtPriceX96), 202919, 200000, 205000)
+		assert.NotNil(t, err)
+		_, _, err = AmountsForLiquidity(big.NewInt(-1), mustInt(t, usdcWethSqrtPriceX96), 202919, 200000, 205000)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestInRange(t *testing.T) {
+	assert.True(t, InRange(0, -60, 60))
+	assert.True(t, InRange(-60, -60, 60))
+	assert.False(t, InRange(60, -60, 60))
+	assert.False(t, InRange(-61, -60, 60))
+}
+
+func TestValuePosition(t *testing.T) {
+	position := Position{
+		Liquidity:    big.NewInt(1e18),
+		TickLower:    200000,
+		TickUpper:    205000,
+		SqrtPriceX96: mustInt(t, usdcWethSqrtPriceX96),
+		Tick:         202919,
+		Decimals0:    6,
+		Decimals1:    18,
+		Price0USD:    big.NewRat(1, 1),
+		Price1USD:    big.NewRat(2000, 1),
+	}
+
+	t.Run("when prices are set", func(t *testing.T) {
+		value, err := ValuePosition(position)
+		assert.Nil(t, err)
+		assert.True(t, value.InRange)
+		assert.Equal(t, "3877662.144086", value.Amount0.FloatString(6))
+		assert.Equal(t, "3460.117403312826577811", value.Amount1.FloatString(18))
+		assert.Equal(t, "3877662.144086", value.Amount0USD.FloatString(6))
+		assert.Equal(t, "6920234.806625653155622", value.Amount1USD.FloatString(15))
+		assert.Equal(t, "10797896.950711653155622", value.ValueUSD.FloatString(15))
+	})
+
+	t.Run("when prices are not set", func(t *testing.T) {
+		p := position
+		p.Price0USD = nil
+		value, err := ValuePosition(p)
+		assert.Nil(t, err)
+		assert.Equal(t, "3877662.144086", value.Amount0.FloatString(6))
+		assert.Nil(t, value.ValueUSD)
+	})
+
+	t.Run("when out of range", func(t *testing.T) {
+		p := position
+		p.TickLower = 203000
+		value, err := ValuePosition(p)
+		assert.Nil(t, err)
+		assert.False(t, value.InRange)
+		assert.Equal(t, 0, value.Amount1.Sign())
+	})
+
+	t.Run("when decimals are invalid", func(t *testing.T) {
+		p := position
+		p.Decimals1 = -1
+		_, err := ValuePosition(p)
+		assert.NotNil(t, err)
+	})
+}
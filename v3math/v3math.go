@@ -0,0 +1,203 @@
+// Package v3math converts between Uniswap v3 ticks, Q64.96 square root prices and human readable prices.
+//
+// prices are the price of token0 in units of token1 (e.g. USDC per WETH in a WETH/USDC pool), adjusted for the
+// decimals of both tokens, and are returned as exact *big.Rat values. ticks are converted to square root prices with
+// the same integer math as the TickMath library of the v3 core contracts, so results match the chain exactly.
+package v3math
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+const (
+	MinTick = -887272 // the minimum tick of a v3 pool
+	MaxTick = 887272  // the maximum tick of a v3 pool
+)
+
+var (
+	MinSqrtPriceX96 = big.NewInt(4295128739)                                                // the square root price at MinTick
+	MaxSqrtPriceX96 = mustBigInt("1461446703485210103287273052203988822378723970342")       // the square root price at MaxTick
+	q192            = new(big.Int).Lsh(big.NewInt(1), 192)                                  // 2^192
+	maxUint256      = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1)) // 2^256 - 1
+	q32Mask         = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 32), big.NewInt(1))  // 2^32 - 1
+	tickRatioOne    = mustBigInt("0x100000000000000000000000000000000")                     // 1 as a Q128.128
+	tickRatioOdd    = mustBigInt("0xfffcb933bd6fad37aa2d162d1a594001")                      // 1/sqrt(1.0001) as a Q128.128
+	tickRatios      = []*big.Int{                                                           // 1/sqrt(1.0001)^(2^i) as Q128.128s, for i >= 1
+		mustBigInt("0xfff97272373d413259a46990580e213a"),
+		mustBigInt("0xfff2e50f5f656932ef12357cf3c7fdcc"),
+		mustBigInt("0xffe5caca7e10e4e61c3624eaa0941cd0"),
+		mustBigInt("0xffcb9843d60f6159c9db58835c926644"),
+		mustBigInt("0xff973b41fa98c081472e6896dfb254c0"),
+		mustBigInt("0xff2ea16466c96a3843ec78b326b52861"),
+		mustBigInt("0xfe5dee046a99a2a811c461f1969c3053"),
+		mustBigInt("0xfcbe86c7900a88aedcffc83b479aa3a4"),
+		mustBigInt("0xf987a7253ac413176f2b074cf7815e54"),
+		mustBigInt("0xf3392b0822b70005940c7a398e4b70f3"),
+		mustBigInt("0xe7159475a2c29b7443b29c7fa6e889d9"),
+		mustBigInt("0xd097f3bdfd2022b8845ad8f792aa5825"),
+		mustBigInt("0xa9f746462d870fdf8a65dc1f90e061e5"),
+		mustBigInt("0x70d869a156d2a1b890bb3df62baf32f7"),
+		mustBigInt("0x31be135f97d08fd981231505542fcfa6"),
+		mustBigInt("0x9aa508b5b7a84e1c677de54f3e99bc9"),
+		mustBigInt("0x5d6af8dedb81196699c329225ee604"),
+		mustBigInt("0x2216e584f5fa1ea926041bedfe98"),
+		mustBigInt("0x48a170391f7dc42444e8fa2"),
+	}
+)
+
+func mustBigInt(s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 0)
+	if !ok {
+		panic("v3math: invalid constant " + s)
+	}
+	return b
+}
+
+// TickToSqrtPriceX96 returns sqrt(1.0001^tick) * 2^96, rounded as in TickMath.getSqrtRatioAtTick
+func TickToSqrtPriceX96(tick int) (*big.Int, error) {
+	if tick < MinTick || tick > MaxTick {
+		return nil, fmt.Errorf("v3math error: tick %d out of range [%d, %d]", tick, MinTick, MaxTick)
+	}
+	absTick := tick
+	if absTick < 0 {
+		absTick = -absTick
+	}
+
+	ratio := new(big.Int).Set(tickRatioOne)
+	if absTick&1 != 0 {
+		ratio.Set(tickRatioOdd)
+	}
+	for i, r := range tickRatios {
+		if absTick&(2<<i) != 0 {
+			ratio.Mul(ratio, r)
+			ratio.Rsh(ratio, 128)
+		}
+	}
+	if tick > 0 {
+		ratio.Quo(maxUint256, ratio)
+	}
+
+	// Q128.128 to Q64.96, rounding up
+	roundUp := new(big.Int).And(ratio, q32Mask).Sign() != 0
+	ratio.Rsh(ratio, 32)
+	if roundUp {
+		ratio.Add(ratio, big.NewInt(1))
+	}
+	return ratio, nil
+}
+
+// SqrtPriceX96ToTick returns the greatest tick whose square root price is less than or equal to sqrtPriceX96, as in
+// TickMath.getTickAtSqrtRatio. sqrtPriceX96 must be in [MinSqrtPriceX96, MaxSqrtPriceX96).
+func SqrtPriceX96ToTick(sqrtPriceX96 *big.Int) (int, error) {
+	if sqrtPriceX96 == nil {
+		return 0, errors.New("v3math error: sqrtPriceX96 is nil")
+	}
+	if sqrtPriceX96.Cmp(MinSqrtPriceX96) < 0 || sqrtPriceX96.Cmp(MaxSqrtPriceX96) >= 0 {
+		return 0, fmt.Errorf("v3math error: sqrtPriceX96 %s out of range [%s, %s)", sqrtPriceX96, MinSqrtPriceX96, MaxSqrtPriceX96)
+	}
+
+	// binary search for the greatest tick in [lo, hi] with TickToSqrtPriceX96(tick) <= sqrtPriceX96
+	lo, hi := MinTick, MaxTick
+	for lo < hi {
+		mid := lo + (hi-lo+1)/2
+		sqrtPrice, err := TickToSqrtPriceX96(mid)
+		if err != nil {
+			return 0, err
+		}
+		if sqrtPrice.Cmp(sqrtPriceX96) <= 0 {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+	return lo, nil
+}
+
+// SqrtPriceX96ToPrice returns the price of token0 in units of token1, given a Q64.96 square root price (e.g.
+// Pool.SqrtPrice or Swap.SqrtPriceX96) and the decimals of both tokens
+func SqrtPriceX96ToPrice(sqrtPriceX96 *big.Int, decimals0 int, decimals1 int) (*big.Rat, error) {
+	if sqrtPriceX96 == nil || sqrtPriceX96.Sign() <= 0 {
+		return nil, fmt.Errorf("v3math error: invalid sqrtPriceX96 %v", sqrtPriceX96)
+	}
+	if decimals0 < 0 || decimals1 < 0 {
+		return nil, fmt.Errorf("v3math error: invalid decimals (%d, %d)", decimals0, decimals1)
+	}
+	raw := new(big.Rat).SetFrac(new(big.Int).Mul(sqrtPriceX96, sqrtPriceX96), q192)
+	return raw.Mul(raw, decimalsAdjustment(decimals0, decimals1)), nil
+}
+
+// TickToPrice returns the price of token0 in units of token1 at a tick, given the decimals of both tokens
+func TickToPrice(tick int, decimals0 int, decimals1 int) (*big.Rat, error) {
+	sqrtPriceX96, err := TickToSqrtPriceX96(tick)
+	if err != nil {
+		return nil, err
+	}
+	return SqrtPriceX96ToPrice(sqrtPriceX96, decimals0, decimals1)
+}
+
+// PriceToTick returns the greatest tick whose price is less than or equal to price, where price is the price of
+// token0 in units of token1. the result can be rounded to a pool's tick spacing with NearestUsableTick.
+func PriceToTick(price *big.Rat, decimals0 int, decimals1 int) (int, error) {
+	if price == nil || price.Sign() <= 0 {
+		return 0, fmt.Errorf("v3math error: invalid price %v", price)
+	}
+	if decimals0 < 0 || decimals1 < 0 {
+		return 0, fmt.Errorf("v3math error: invalid decimals (%d, %d)", decimals0, decimals1)
+	}
+
+	// sqrtPriceX96 = floor(sqrt(raw * 2^192)). flooring before the square root doesn't change the result, and
+	// TickToSqrtPriceX96(tick) <= floor(x) exactly when TickToSqrtPriceX96(tick) <= x.
+	raw := new(big.Rat).Quo(price, decimalsAdjustment(decimals0, decimals1))
+	scaled := new(big.Int).Mul(raw.Num(), q192)
+	scaled.Quo(scaled, raw.Denom())
+	sqrtPriceX96 := scaled.Sqrt(scaled)
+
+	switch {
+	case sqrtPriceX96.Cmp(MinSqrtPriceX96) < 0:
+		return 0, fmt.Errorf("v3math error: price %s is below the price at MinTick", price.FloatString(18))
+	case sqrtPriceX96.Cmp(MaxSqrtPriceX96) >= 0:
+		return MaxTick, nil
+	}
+	return SqrtPriceX96ToTick(sqrtPriceX96)
+}
+
+// NearestUsableTick rounds a tick to the nearest multiple of tickSpacing (e.g. 60 for 0.3% pools) within
+// [MinTick, MaxTick]
+func NearestUsableTick(tick int, tickSpacing int) (int, error) {
+	if tickSpacing <= 0 {
+		return 0, fmt.Errorf("v3math error: invalid tick spacing %d", tickSpacing)
+	}
+	if tick < MinTick || tick > MaxTick {
+		return 0, fmt.Errorf("v3math error: tick %d out of range [%d, %d]", tick, MinTick, MaxTick)
+	}
+	rounded := tick / tickSpacing * tickSpacing
+	rem := tick - rounded
+	if rem*2 >= tickSpacing {
+		rounded += tickSpacing
+	} else if rem*2 < -tickSpacing {
+		rounded -= tickSpacing
+	}
+	switch {
+	case rounded < MinTick:
+		rounded += tickSpacing
+	case rounded > MaxTick:
+		rounded -= tickSpacing
+	}
+	return rounded, nil
+}
+
+// returns 10^(decimals0 - decimals1), which converts a raw price (token1 base units per token0 base unit) to a
+// price in whole tokens
+func decimalsAdjustment(decimals0 int, decimals1 int) *big.Rat {
+	exp := decimals0 - decimals1
+	if exp < 0 {
+		return new(big.Rat).SetFrac(big.NewInt(1), pow10(-exp))
+	}
+	return new(big.Rat).SetInt(pow10(exp))
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
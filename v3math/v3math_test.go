@@ -0,0 +1,237 @@
+package v3math
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mustInt(t *testing.T, s string) *big.Int {
+	b, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		t.Fatalf("invalid big int %q", s)
+	}
+	return b
+}
+
+// sqrtPriceX96 and tick of the USDC/WETH 0.3% pool (0x8ad599c3a0ff1de082011efddc58f1908eb6e6d8) from slot0, as
+// worked through in Uniswap's "A primer on Uniswap v3 math"
+const usdcWethSqrtPriceX96 = "2018382873588440326581633304624437"
+
+func TestTickToSqrtPriceX96(t *testing.T) {
+	tests := map[string]struct {
+		tick    int
+		want    string
+		wantErr bool
+	}{
+		"min tick":       {tick: MinTick, want: "4295128739"},
+		"max tick":       {tick: MaxTick, want: "1461446703485210103287273052203988822378723970342"},
+		"zero":           {tick: 0, want: "79228162514264337593543950336"},
+		"one":            {tick: 1, want: "79232123823359799118286999568"},
+		"minus one":      {tick: -1, want: "79224201403219477170569942574"},
+		"usdc/weth":      {tick: 202919, want: "2018317010999599141479991542265040"},
+		"below min tick": {tick: MinTick - 1, wantErr: true},
+		"above max tick": {tick: MaxTick + 1, wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := TickToSqrtPriceX96(test.tick)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got.String())
+		})
+	}
+}
+
+func TestSqrtPriceX96ToTick(t *testing.T) {
+	tests := map[string]struct {
+		sqrtPriceX96 string
+		want         int
+		wantErr      bool
+	}{
+		"min sqrt price":       {sqrtPriceX96: "4295128739", want: MinTick},
+		"max sqrt price - 1":   {sqrtPriceX96: "1461446703485210103287273052203988822378723970341", want: MaxTick - 1},
+		"zero tick":            {sqrtPriceX96: "79228162514264337593543950336", want: 0},
+		"between ticks":        {sqrtPriceX96: "79228162514264337593543950337", want: 0},
+		"just below zero tick": {sqrtPriceX96: "79228162514264337593543950335", want: -1},
+		"usdc/weth":            {sqrtPriceX96: usdcWethSqrtPriceX96, want: 202919},
+		"below min":            {sqrtPriceX96: "4295128738", wantErr: true},
+		"max sqrt price":       {sqrtPriceX96: "1461446703485210103287273052203988822378723970342", wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := SqrtPriceX96ToTick(mustInt(t, test.sqrtPriceX96))
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("when round tripping ticks", func(t *testing.T) {
+		for _, tick := range []int{MinTick, -500000, -276225, -74960, -1, 0, 1, 60, 202919, 500000, MaxTick - 1} {
+			sqrtPriceX96, err := TickToSqrtPriceX96(tick)
+			assert.Nil(t, err)
+			got, err := SqrtPriceX96ToTick(sqrtPriceX96)
+			assert.Nil(t, err)
+			assert.Equal(t, tick, got)
+		}
+	})
+
+	t.Run("when nil", func(t *testing.T) {
+		_, err := SqrtPriceX96ToTick(nil)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestSqrtPriceX96ToPrice(t *testing.T) {
+	t.Run("when the tokens have different decimals", func(t *testing.T) {
+		// token0 is USDC (6 decimals) and token1 is WETH (18 decimals), so the price is WETH per USDC
+		price, err := SqrtPriceX96ToPrice(mustInt(t, usdcWethSqrtPriceX96), 6, 18)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.000649004843", price.FloatString(12))
+		assert.Equal(t, "1540.820552", new(big.Rat).Inv(price).FloatString(6))
+	})
+
+	t.Run("when the price is 1", func(t *testing.T) {
+		price, err := SqrtPriceX96ToPrice(mustInt(t, "79228162514264337593543950336"), 18, 18)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewRat(1, 1), price)
+	})
+
+	t.Run("when the price is exact", func(t *testing.T) {
+		// sqrtPriceX96 = 2 * 2^96 is a raw price of exactly 4
+		price, err := SqrtPriceX96ToPrice(mustInt(t, "158456325028528675187087900672"), 6, 18)
+		assert.Nil(t, err)
+		assert.Equal(t, "0.000000000004", price.FloatString(12))
+	})
+
+	t.Run("when invalid", func(t *testing.T) {
+		_, err := SqrtPriceX96ToPrice(nil, 18, 18)
+		assert.NotNil(t, err)
+		_, err = SqrtPriceX96ToPrice(big.NewInt(0), 18, 18)
+		assert.NotNil(t, err)
+		_, err = SqrtPriceX96ToPrice(big.NewInt(1), -1, 18)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestTickToPrice(t *testing.T) {
+	// vectors from the v3 sdk's tickToPrice tests
+	tests := map[string]struct {
+		tick      int
+		decimals0 int
+		decimals1 int
+		invert    bool
+		places    int
+		want      string
+	}{
+		"1800 t0 per t1":   {tick: -74959, decimals0: 18, decimals1: 18, invert: true, places: 2, want: "1799.97"},
+		"1 t1 per 1800 t0": {tick: -74959, decimals0: 18, decimals1: 18, places: 8, want: "0.00055556"},
+		"1.01 (18/6)":      {tick: -276225, decimals0: 18, decimals1: 6, places: 6, want: "1.009951"},
+		"zero":             {tick: 0, decimals0: 18, decimals1: 18, places: 0, want: "1"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			price, err := TickToPrice(test.tick, test.decimals0, test.decimals1)
+			assert.Nil(t, err)
+			if test.invert {
+				price.Inv(price)
+			}
+			assert.Equal(t, test.want, price.FloatString(test.places))
+		})
+	}
+
+	t.Run("when out of range", func(t *testing.T) {
+		_, err := TickToPrice(MaxTick+1, 18, 18)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestPriceToTick(t *testing.T) {
+	tests := map[string]struct {
+		price     *big.Rat
+		decimals0 int
+		decimals1 int
+		want      int
+		wantErr   bool
+	}{
+		// vectors from the v3 sdk's priceToClosestTick tests
+		"1 t1 per 1800 t0": {price: big.NewRat(1, 1800), decimals0: 18, decimals1: 18, want: -74960},
+		"1.01 (18/6)":      {price: big.NewRat(101, 100), decimals0: 18, decimals1: 6, want: -276225},
+		"usdc/weth":        {price: new(big.Rat).Inv(big.NewRat(154082, 100)), decimals0: 6, decimals1: 18, want: 202919},
+		"one":              {price: big.NewRat(1, 1), decimals0: 18, decimals1: 18, want: 0},
+		"above max":        {price: big.NewRat(1e18, 1), decimals0: 0, decimals1: 36, want: MaxTick},
+		"below min":        {price: big.NewRat(1, 1e18), decimals0: 36, decimals1: 0, wantErr: true},
+		"zero":             {price: new(big.Rat), decimals0: 18, decimals1: 18, wantErr: true},
+		"negative":         {price: big.NewRat(-1, 1), decimals0: 18, decimals1: 18, wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := PriceToTick(test.price, test.decimals0, test.decimals1)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+
+	t.Run("when the price is exactly a tick's price", func(t *testing.T) {
+		for _, tick := range []int{-74960, -1, 0, 1, 202919} {
+			price, err := TickToPrice(tick, 6, 18)
+			assert.Nil(t, err)
+			got, err := PriceToTick(price, 6, 18)
+			assert.Nil(t, err)
+			assert.Equal(t, tick, got)
+
+			// just below the tick's price is the previous tick
+			price.Mul(price, big.NewRat(1e18-1, 1e18))
+			got, err = PriceToTick(price, 6, 18)
+			assert.Nil(t, err)
+			assert.Equal(t, tick-1, got)
+		}
+	})
+}
+
+func TestNearestUsableTick(t *testing.T) {
+	tests := map[string]struct {
+		tick        int
+		tickSpacing int
+		want        int
+		wantErr     bool
+	}{
+		"already usable":    {tick: 120, tickSpacing: 60, want: 120},
+		"rounds down":       {tick: 29, tickSpacing: 60, want: 0},
+		"rounds up at half": {tick: 30, tickSpacing: 60, want: 60},
+		"negative":          {tick: -31, tickSpacing: 60, want: -60},
+		"negative half":     {tick: -30, tickSpacing: 60, want: 0},
+		"min tick":          {tick: MinTick, tickSpacing: 60, want: -887220},
+		"max tick":          {tick: MaxTick, tickSpacing: 60, want: 887220},
+		"invalid spacing":   {tick: 0, tickSpacing: 0, wantErr: true},
+		"tick out of range": {tick: MaxTick + 1, tickSpacing: 1, wantErr: true},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := NearestUsableTick(test.tick, test.tickSpacing)
+			if test.wantErr {
+				assert.NotNil(t, err)
+				return
+			}
+			assert.Nil(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
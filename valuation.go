@@ -0,0 +1,76 @@
+package unigraphclient
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/emersonmacro/go-uniswap-subgraph-client/v3math"
+)
+
+// fields of a Position needed by Position.Value (and typed.Position.Value), for RequestOptions.IncludeFields
+var PositionValueFields = []string{
+	"id",
+	"liquidity",
+	"tickLower.tickIdx",
+	"tickUpper.tickIdx",
+	"pool.sqrtPrice",
+	"pool.tick",
+	"token0.decimals",
+	"token0.derivedETH",
+	"token1.decimals",
+	"token1.derivedETH",
+}
+
+// Value returns the tokens held by the position, whether it is in range, and their value in USD (see
+// v3math.ValuePosition). token prices are token.derivedETH * bundle.ethPriceUSD. the position must include
+// PositionValueFields, and the bundle ethPriceUSD.
+func (p Position) Value(bundle Bundle) (*v3math.PositionValue, error) {
+	liquidity, ok := new(big.Int).SetString(p.Liquidity, 10)
+	if !ok {
+		return nil, positionValueError("liquidity", p.Liquidity)
+	}
+	sqrtPriceX96, ok := new(big.Int).SetString(p.Pool.SqrtPrice, 10)
+	if !ok {
+		return nil, positionValueError("pool.sqrtPrice", p.Pool.SqrtPrice)
+	}
+
+	position := v3math.Position{Liquidity: liquidity, SqrtPriceX96: sqrtPriceX96}
+	ints := []struct {
+		field string
+		value string
+		dest  *int
+	}{
+		{"tickLower.tickIdx", p.TickLower.TickIdx, &position.TickLower},
+		{"tickUpper.tickIdx", p.TickUpper.TickIdx, &position.TickUpper},
+		{"pool.tick", p.Pool.Tick, &position.Tick},
+		{"token0.decimals", p.Token0.Decimals, &position.Decimals0},
+		{"token1.decimals", p.Token1.Decimals, &position.Decimals1},
+	}
+	for _, i := range ints {
+		v, err := strconv.Atoi(i.value)
+		if err != nil {
+			return nil, positionValueError(i.field, i.value)
+		}
+		*i.dest = v
+	}
+
+	ethPriceUSD, ok := new(big.Rat).SetString(bundle.EthPriceUSD)
+	if !ok {
+		return nil, positionValueError("bundle.ethPriceUSD", bundle.EthPriceUSD)
+	}
+	if position.Price0USD, ok = new(big.Rat).SetString(p.Token0.DerivedETH); !ok {
+		return nil, positionValueError("token0.derivedETH", p.Token0.DerivedETH)
+	}
+	if position.Price1USD, ok = new(big.Rat).SetString(p.Token1.DerivedETH); !ok {
+		return nil, positionValueError("token1.derivedETH", p.Token1.DerivedETH)
+	}
+	position.Price0USD.Mul(position.Price0USD, ethPriceUSD)
+	position.Price1USD.Mul(position.Price1USD, ethPriceUSD)
+
+	return v3math.ValuePosition(position)
+}
+
+func positionValueError(field string, value string) error {
+	return fmt.Errorf("position value error: invalid %s %q (is it included in the query?)", field, value)
+}
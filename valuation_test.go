@@ -0,0 +1,48 @@
+package unigraphclient
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPositionValue(t *testing.T) {
+	position := Position{
+		ID:        "1",
+		Liquidity: "1000000000000000000",
+		TickLower: Tick{TickIdx: "200000"},
+		TickUpper: Tick{TickIdx: "205000"},
+		Pool:      Pool{SqrtPrice: "2018382873588440326581633304624437", Tick: "202919"},
+		Token0:    Token{Decimals: "6", DerivedETH: "0.0005"},
+		Token1:    Token{Decimals: "18", DerivedETH: "1"},
+	}
+	bundle := Bundle{EthPriceUSD: "2000"}
+
+	t.Run("when successful", func(t *testing.T) {
+		value, err := position.Value(bundle)
+		assert.Nil(t, err)
+		assert.True(t, value.InRange)
+		assert.Equal(t, "3877662.144086", value.Amount0.FloatString(6))
+		assert.Equal(t, "3460.117403312826577811", value.Amount1.FloatString(18))
+		assert.Equal(t, "10797896.950711653155622", value.ValueUSD.FloatString(15))
+	})
+
+	t.Run("when a field is not included in the query", func(t *testing.T) {
+		p := position
+		p.TickUpper = Tick{}
+		_, err := p.Value(bundle)
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "tickUpper.tickIdx")
+	})
+
+	t.Run("when ethPriceUSD is missing", func(t *testing.T) {
+		_, err := position.Value(Bundle{})
+		assert.NotNil(t, err)
+		assert.Contains(t, err.Error(), "bundle.ethPriceUSD")
+	})
+
+	t.Run("when PositionValueFields are queried", func(t *testing.T) {
+		_, err := constructByIdQuery("1", PositionFields, &RequestOptions{IncludeFields: PositionValueFields})
+		assert.Nil(t, err)
+	})
+}